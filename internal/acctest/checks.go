@@ -12,11 +12,13 @@ import (
 	"testing"
 	"time"
 
+	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared"
 	"github.com/canonical/lxd/shared/api"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/utils"
 )
 
@@ -368,6 +370,156 @@ func ConfigureTrustToken(t *testing.T) string {
 	return token.String()
 }
 
+// CheckBearerToken is a test check function that verifies whether the bearer
+// token stored in the given resource attribute is accepted by the LXD server
+// listening on https://127.0.0.1:8443.
+func CheckBearerToken(resName string, attr string, accepted bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("Resource %q not found", resName)
+		}
+
+		token := rs.Primary.Attributes[attr]
+		if token == "" {
+			return fmt.Errorf("Resource %q has no bearer token in attribute %q", resName, attr)
+		}
+
+		serverCert, err := shared.GetRemoteCertificate(context.Background(), "https://127.0.0.1:8443", "test")
+		if err != nil {
+			return fmt.Errorf("Failed to get server certificate: %w", err)
+		}
+
+		remotes := map[string]provider_config.LxdRemote{
+			"https-remote": {
+				Protocol:                     "lxd",
+				Address:                      "https://127.0.0.1:8443",
+				ServerCertificateFingerprint: shared.CertFingerprint(serverCert),
+				BearerToken:                  token,
+			},
+		}
+
+		p, err := provider_config.NewLxdProviderConfig("test", remotes, "https-remote", 0)
+		if err != nil {
+			return err
+		}
+
+		// Connection fails if the token is rejected.
+		isAccepted := false
+		server, err := p.InstanceServer("https-remote", "", "")
+		if err == nil {
+			apiServer, _, err := server.GetServer()
+			isAccepted = err == nil && apiServer.Auth == "trusted"
+		}
+
+		if isAccepted != accepted {
+			return fmt.Errorf("Expected bearer token acceptance to be %t, got %t", accepted, isAccepted)
+		}
+
+		return nil
+	}
+}
+
+// CheckValueNotPersisted is a test check function that verifies that the
+// value of the given resource attribute, typically an ephemeral value stored
+// by the echo provider, is not persisted by any other resource in the state.
+func CheckValueNotPersisted(resName string, attr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("Resource %q not found", resName)
+		}
+
+		value := rs.Primary.Attributes[attr]
+		if value == "" {
+			return fmt.Errorf("Resource %q has no value in attribute %q", resName, attr)
+		}
+
+		for name, r := range s.RootModule().Resources {
+			if name == resName {
+				continue
+			}
+
+			for k, v := range r.Primary.Attributes {
+				if v == value {
+					return fmt.Errorf("Value of %s.%s is persisted in %s.%s", resName, attr, name, k)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// CheckTrustTokenPending is a test check function that verifies whether
+// a pending trust token with the given name exists.
+func CheckTrustTokenPending(name string, pending bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		server, err := testProvider().InstanceServer("", "", "")
+		if err != nil {
+			return err
+		}
+
+		operationIDs, err := trustTokenOperations(server, name)
+		if err != nil {
+			return err
+		}
+
+		isPending := len(operationIDs) > 0
+		if isPending != pending {
+			return fmt.Errorf("Expected trust token %q pending to be %t, got %t", name, pending, isPending)
+		}
+
+		return nil
+	}
+}
+
+// DeleteTrustToken removes pending trust tokens with the given name. It is
+// used to clean up tokens that are intentionally left behind by tests.
+func DeleteTrustToken(t *testing.T, name string) {
+	server, err := testProvider().InstanceServer("", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operationIDs, err := trustTokenOperations(server, name)
+	if err != nil {
+		t.Logf("Failed to retrieve trust token %q during cleanup: %v", name, err)
+		return
+	}
+
+	for _, id := range operationIDs {
+		err := server.DeleteOperation(id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			t.Logf("Failed to delete trust token %q during cleanup: %v", name, err)
+		}
+	}
+}
+
+// trustTokenOperations returns IDs of the operations backing pending trust
+// tokens with the given name.
+func trustTokenOperations(server lxd.InstanceServer, name string) ([]string, error) {
+	operations, err := server.GetOperations()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve operations: %w", err)
+	}
+
+	var operationIDs []string
+	for i := range operations {
+		token, err := operations[i].ToCertificateAddToken()
+		if err != nil {
+			// Not a trust token operation.
+			continue
+		}
+
+		if token.ClientName == name && operations[i].StatusCode == api.Running {
+			operationIDs = append(operationIDs, operations[i].ID)
+		}
+	}
+
+	return operationIDs, nil
+}
+
 // PrintResourceState is a test check function that prints the entire state
 // of a resource with the given name. This check should be used only for
 // debuging purposes.
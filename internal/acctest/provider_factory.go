@@ -1,19 +1,14 @@
 package acctest
 
 import (
-	"errors"
 	"fmt"
-	"io/fs"
 	"maps"
-	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 
-	lxdConfig "github.com/canonical/lxd/lxc/config"
-	"github.com/canonical/lxd/shared"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/provider"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
@@ -50,6 +45,14 @@ var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 	"lxd": providerserver.NewProtocol6WithError(provider.NewLxdProvider("test")()),
 }
 
+// ProtoV6ProviderFactoriesWithEcho are the same as ProtoV6ProviderFactories,
+// but additionally include the echo provider. The echo provider stores its
+// input in the state, which allows asserting values of ephemeral resources.
+var ProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"lxd":  providerserver.NewProtocol6WithError(provider.NewLxdProvider("test")()),
+	"echo": echoprovider.NewProviderServer(),
+}
+
 const testProviderRemoteName = "tf-test"
 
 var testProviderRemote *provider_config.LxdRemote
@@ -85,7 +88,7 @@ func testProvider() *provider_config.LxdProviderConfig {
 	if testProviderConfig == nil {
 		var err error
 
-		testProviderConfig, err = provider_config.NewLxdProviderConfig("test", remotes, testProviderRemoteName)
+		testProviderConfig, err = provider_config.NewLxdProviderConfig("test", remotes, testProviderRemoteName, 0)
 		if err != nil {
 			panic(fmt.Sprintf("Failed to initialize provider: %v", err))
 		}
@@ -113,7 +116,7 @@ func ProviderWithRemotes(extraRemotes map[string]provider_config.LxdRemote) stri
 		maps.Copy(remotes, testRemotes())
 	}
 
-	provider, err := provider_config.NewLxdProviderConfig("test", remotes, testProviderRemoteName)
+	provider, err := provider_config.NewLxdProviderConfig("test", remotes, testProviderRemoteName, 0)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize provider: %v", err))
 	}
@@ -122,89 +125,19 @@ func ProviderWithRemotes(extraRemotes map[string]provider_config.LxdRemote) stri
 }
 
 func parseDefaultLocalConfigRemote() (*provider_config.LxdRemote, error) {
-	config, configDir, err := loadLocalConfig("")
+	remotes, remoteName, err := provider_config.LoadLxcConfig("")
 	if err != nil {
 		return nil, err
 	}
 
-	remoteName := config.DefaultRemote
-	remote, ok := config.Remotes[remoteName]
+	remote, ok := remotes[remoteName]
 	if !ok {
 		return nil, fmt.Errorf("Default remote %q not found in config", remoteName)
 	}
 
-	if remote.Protocol == "" {
-		remote.Protocol = "lxd"
-	}
-
 	if remote.Protocol != "lxd" {
 		return nil, fmt.Errorf("Default remote %q is using unsupported protocol %q: Only the lxd protocol is supported", remoteName, remote.Protocol)
 	}
 
-	r := &provider_config.LxdRemote{
-		Address:  remote.Addr,
-		Protocol: remote.Protocol,
-	}
-
-	if remote.AuthType == "tls" {
-		// Load client certificate and key from config directory.
-		clientCertPath := filepath.Join(configDir, "client.crt")
-		clientCert, err := os.ReadFile(clientCertPath)
-		if err != nil {
-			return nil, fmt.Errorf("Failed to read client certificate %q: %v", clientCertPath, err)
-		}
-
-		clientKeyPath := filepath.Join(configDir, "client.key")
-		clientKey, err := os.ReadFile(clientKeyPath)
-		if err != nil {
-			return nil, fmt.Errorf("Failed to read client key %q: %v", clientKeyPath, err)
-		}
-
-		r.ClientCertificate = string(clientCert)
-		r.ClientKey = string(clientKey)
-
-		// Load server certificate and compute fingerprint if it exists.
-		// If the certificate does not exist, continue without setting the fingerprint
-		// and let the provider attempt to connect without it, as it might be trusted
-		// by the system's CA store.
-		serverCertPath := filepath.Join(configDir, "servercerts", remoteName+".crt")
-		serverCert, err := shared.ReadCert(serverCertPath)
-		if err != nil && !errors.Is(err, fs.ErrNotExist) {
-			return nil, fmt.Errorf("Failed to read server certificate %q for remote %q: %v", serverCertPath, remoteName, err)
-		}
-
-		if serverCert != nil {
-			r.ServerCertificateFingerprint = shared.CertFingerprint(serverCert)
-		}
-	}
-
-	return r, nil
-}
-
-// loadLocalConfig loads the local LXD configuration from the specified directory or
-// from the default location if no directory is provided.
-// It returns the loaded configuration, the directory from which it was loaded.
-func loadLocalConfig(configDir string) (*lxdConfig.Config, string, error) {
-	// Determine LXD config directory.
-	if configDir == "" {
-		// Determine LXD configuration directory. First check for the presence
-		// of the /var/snap/lxd directory. If the directory exists, return
-		// snap's config path. Otherwise return the fallback path.
-		_, err := os.Stat("/var/snap/lxd")
-		if err == nil || os.IsExist(err) {
-			configDir = "$HOME/snap/lxd/common/config"
-		} else {
-			configDir = "$HOME/.config/lxc"
-		}
-	}
-
-	configDir = os.ExpandEnv(configDir)
-	configPath := filepath.Join(configDir, "config.yml")
-
-	config, err := lxdConfig.LoadConfig(configPath)
-	if err != nil {
-		return nil, "", fmt.Errorf("Failed to load LXD config from %q: %v", configPath, err)
-	}
-
-	return config, configDir, nil
+	return &remote, nil
 }
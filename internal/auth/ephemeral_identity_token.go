@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// identityTokenPrivateKey is the private data key under which the information
+// required to revoke the bearer token is stored between Open and Close.
+const identityTokenPrivateKey = "identity_token"
+
+// AuthIdentityTokenModel represents the Terraform model for an LXD identity
+// bearer token.
+type AuthIdentityTokenModel struct {
+	Identity      types.String `tfsdk:"identity"`
+	Expiry        types.String `tfsdk:"expiry"`
+	Remote        types.String `tfsdk:"remote"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+
+	// Computed.
+	Token types.String `tfsdk:"token"`
+}
+
+// identityTokenPrivateData contains the information required to revoke the
+// bearer token when the ephemeral resource is closed.
+type identityTokenPrivateData struct {
+	Remote   string `json:"remote"`
+	Identity string `json:"identity"`
+}
+
+// AuthIdentityTokenEphemeralResource issues bearer tokens for LXD identities.
+type AuthIdentityTokenEphemeralResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewAuthIdentityTokenEphemeralResource returns a new [AuthIdentityTokenEphemeralResource].
+func NewAuthIdentityTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AuthIdentityTokenEphemeralResource{}
+}
+
+func (r AuthIdentityTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_identity_token"
+}
+
+func (r AuthIdentityTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"identity": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or identifier of the bearer identity.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"expiry": schema.StringAttribute{
+				Optional:    true,
+				Description: "Token lifetime, for example 1H or 30M. If not set, the server default applies.",
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"revoke_on_close": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to revoke the token once Terraform no longer needs it. Defaults to false.",
+			},
+
+			// Computed.
+
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *AuthIdentityTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r AuthIdentityTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config AuthIdentityTokenModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := config.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	err = server.CheckExtension("auth_bearer")
+	if err != nil {
+		resp.Diagnostics.AddError("Bearer identities are not supported", err.Error())
+		return
+	}
+
+	identity := config.Identity.ValueString()
+	tokenReq := api.IdentityBearerTokenPost{
+		Expiry: config.Expiry.ValueString(),
+	}
+
+	token, err := server.IssueBearerIdentityToken(identity, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to issue bearer token for identity %q", identity), err.Error())
+		return
+	}
+
+	// Store the identity to revoke the token on close. By default, the
+	// token outlives the run, as it is typically handed over to other
+	// systems.
+	if config.RevokeOnClose.ValueBool() {
+		privateData, err := json.Marshal(identityTokenPrivateData{
+			Remote:   remote,
+			Identity: identity,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to encode private data for identity %q token", identity), err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, identityTokenPrivateKey, privateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config.Token = types.StringValue(token.Token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r AuthIdentityTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, identityTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData identityTokenPrivateData
+	err := json.Unmarshal(privateBytes, &privateData)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to decode private data of identity token", err.Error())
+		return
+	}
+
+	server, err := r.provider.InstanceServer(privateData.Remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// The identity may have been removed in the meantime, in which case
+	// the token is no longer valid.
+	err = server.RevokeBearerIdentityToken(privateData.Identity)
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to revoke bearer token for identity %q", privateData.Identity), err.Error())
+	}
+}
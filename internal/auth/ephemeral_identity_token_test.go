@@ -0,0 +1,68 @@
+package auth_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccIdentityToken_ephemeral(t *testing.T) {
+	identity := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "access_management", "auth_bearer")
+			acctest.PreCheckLocalServerHTTPS(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Create identity first, so that the token is issued
+				// for an existing identity.
+				Config: acctest.Provider() + testAccIdentity_bearer(identity, []string{}),
+			},
+			{
+				// By default, the token remains valid after the run.
+				Config: acctest.Provider() + testAccIdentityToken_ephemeral(identity, "1H", "token", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.token", "data.identity", identity),
+					resource.TestCheckResourceAttrSet("echo.token", "data.token"),
+					acctest.CheckValueNotPersisted("echo.token", "data.token"),
+					acctest.CheckBearerToken("echo.token", "data.token", true),
+				),
+			},
+			{
+				// The token is revoked once the run is finished.
+				Config: acctest.Provider() + testAccIdentityToken_ephemeral(identity, "1H", "token_revoked", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.token_revoked", "data.token"),
+					acctest.CheckValueNotPersisted("echo.token_revoked", "data.token"),
+					acctest.CheckBearerToken("echo.token_revoked", "data.token", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityToken_ephemeral(identity string, expiry string, echoName string, revokeOnClose string) string {
+	return testAccIdentity_bearer(identity, []string{}) + fmt.Sprintf(`
+ephemeral "lxd_auth_identity_token" "token" {
+  identity        = lxd_auth_identity.identity.name
+  expiry          = %q
+  revoke_on_close = %s
+}
+
+provider "echo" {
+  data = ephemeral.lxd_auth_identity_token.token
+}
+
+resource "echo" %q {}
+	`, expiry, revokeOnClose, echoName)
+}
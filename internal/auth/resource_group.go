@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,7 @@ type AuthGroupModel struct {
 	Description types.String      `tfsdk:"description"`
 	Permissions []PermissionModel `tfsdk:"permissions"`
 	Remote      types.String      `tfsdk:"remote"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // AuthGroupResource manages LXD auth groups.
@@ -43,7 +45,7 @@ func (r AuthGroupResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_auth_group"
 }
 
-func (r AuthGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r AuthGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -82,6 +84,9 @@ func (r AuthGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"remote": schema.StringAttribute{
 				Optional: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -108,6 +113,15 @@ func (r AuthGroupResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -137,7 +151,7 @@ func (r AuthGroupResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	diags := plan.TaintState(ctx, &resp.State)
+	diags = plan.TaintState(ctx, &resp.State)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -154,6 +168,15 @@ func (r AuthGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -161,7 +184,7 @@ func (r AuthGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	diags := r.SyncState(ctx, &resp.State, server, state, true)
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -175,6 +198,15 @@ func (r AuthGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -206,7 +238,7 @@ func (r AuthGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	diags := r.SyncState(ctx, &resp.State, server, plan, false)
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -217,6 +249,15 @@ func (r AuthGroupResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
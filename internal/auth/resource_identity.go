@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	lxd "github.com/canonical/lxd/client"
+	lxdShared "github.com/canonical/lxd/shared"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +22,7 @@ import (
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/truststore"
 )
 
 // AuthIdentityModel represents the Terraform state model for an LXD identity.
@@ -29,6 +32,16 @@ type AuthIdentityModel struct {
 	AuthMethod  types.String `tfsdk:"auth_method"`
 	Certificate types.String `tfsdk:"tls_certificate"`
 	Remote      types.String `tfsdk:"remote"`
+
+	// Write-only.
+	CertificateWO        types.String `tfsdk:"tls_certificate_wo"`
+	CertificateWOVersion types.Int64  `tfsdk:"tls_certificate_wo_version"`
+
+	// Computed.
+	CertificateFingerprint types.String `tfsdk:"tls_certificate_fingerprint"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AuthIdentityResource manages LXD identity entries.
@@ -45,7 +58,7 @@ func (r AuthIdentityResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_auth_identity"
 }
 
-func (r AuthIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r AuthIdentityResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -75,11 +88,39 @@ func (r AuthIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"tls_certificate": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("tls_certificate_wo")),
+				},
+			},
+
+			"tls_certificate_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only PEM encoded x509 certificate. The value is never stored in the Terraform plan or state.",
+			},
+
+			"tls_certificate_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the write-only certificate. Changing the version forces the certificate to be updated.",
 			},
 
 			"remote": schema.StringAttribute{
 				Optional: true,
 			},
+
+			// Computed.
+
+			"tls_certificate_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fingerprint of the identity certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -99,6 +140,61 @@ func (r *AuthIdentityResource) Configure(_ context.Context, req resource.Configu
 	r.provider = provider
 }
 
+func (r *AuthIdentityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *AuthIdentityModel
+	var state *AuthIdentityModel
+	var config *AuthIdentityModel
+
+	// Ignore plan modification if plan is null (on destroy).
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only certificate is available only in the configuration.
+	certificate := config.Certificate
+	if !config.CertificateWO.IsNull() {
+		// Changing the version forces the certificate to be updated.
+		if state != nil && !state.CertificateWOVersion.Equal(plan.CertificateWOVersion) {
+			resp.Plan.SetAttribute(ctx, path.Root("tls_certificate_fingerprint"), types.StringUnknown())
+			return
+		}
+
+		// Unknown write-only certificate cannot be compared, therefore
+		// keep the existing fingerprint until the version changes.
+		if config.CertificateWO.IsUnknown() {
+			return
+		}
+
+		certificate = config.CertificateWO
+	}
+
+	if certificate.IsNull() {
+		return
+	}
+
+	if certificate.IsUnknown() {
+		resp.Plan.SetAttribute(ctx, path.Root("tls_certificate_fingerprint"), types.StringUnknown())
+		return
+	}
+
+	// Evaluate the certificate fingerprint ahead of time, so that
+	// the certificate is updated when it differs from the server's one.
+	x509Cert, err := truststore.ParseCertX509([]byte(certificate.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse certificate of identity %q", plan.Name.ValueString()), err.Error())
+		return
+	}
+
+	resp.Plan.SetAttribute(ctx, path.Root("tls_certificate_fingerprint"), lxdShared.CertFingerprint(x509Cert))
+}
+
 func (r AuthIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AuthIdentityModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -106,6 +202,15 @@ func (r AuthIdentityResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -115,7 +220,6 @@ func (r AuthIdentityResource) Create(ctx context.Context, req resource.CreateReq
 
 	identityName := plan.Name.ValueString()
 	identityAuthMethod := plan.AuthMethod.ValueString()
-	identityTLSCertificate := plan.Certificate.ValueString()
 	identityGroupNames := []string{}
 
 	resp.Diagnostics.Append(plan.Groups.ElementsAs(ctx, &identityGroupNames, false)...)
@@ -123,6 +227,12 @@ func (r AuthIdentityResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	identityTLSCertificate, diags := identityCertificate(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	switch identityAuthMethod {
 	case "tls":
 		req := api.IdentitiesTLSPost{
@@ -161,7 +271,7 @@ func (r AuthIdentityResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	diags := plan.TaintState(ctx, &resp.State)
+	diags = plan.TaintState(ctx, &resp.State)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -178,6 +288,15 @@ func (r AuthIdentityResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -185,7 +304,7 @@ func (r AuthIdentityResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	diags := r.SyncState(ctx, &resp.State, server, state, true)
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -199,6 +318,15 @@ func (r AuthIdentityResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -208,7 +336,6 @@ func (r AuthIdentityResource) Update(ctx context.Context, req resource.UpdateReq
 
 	identityName := plan.Name.ValueString()
 	identityAuthMethod := plan.AuthMethod.ValueString()
-	identityTLSCertificate := plan.Certificate.ValueString()
 	identityGroupNames := []string{}
 
 	resp.Diagnostics.Append(plan.Groups.ElementsAs(ctx, &identityGroupNames, false)...)
@@ -216,6 +343,12 @@ func (r AuthIdentityResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	identityTLSCertificate, diags := identityCertificate(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, etag, err := server.GetIdentity(identityAuthMethod, identityName)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing %q identity %q", identityAuthMethod, identityName), err.Error())
@@ -233,7 +366,7 @@ func (r AuthIdentityResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	diags := r.SyncState(ctx, &resp.State, server, plan, false)
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -244,6 +377,15 @@ func (r AuthIdentityResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -291,10 +433,21 @@ func (r AuthIdentityResource) SyncState(ctx context.Context, tfState *tfsdk.Stat
 
 	m.Name = types.StringValue(identity.Name)
 	m.AuthMethod = types.StringValue(identityAuthMethod)
-	if identity.TLSCertificate != "" {
+
+	// Do not populate the certificate if it is provided through the
+	// write-only attribute, in which case only the fingerprint is tracked.
+	// Otherwise, the certificate is populated also on import.
+	if identity.TLSCertificate != "" && (!m.Certificate.IsNull() || m.CertificateFingerprint.IsNull()) {
 		m.Certificate = types.StringValue(identity.TLSCertificate)
 	}
 
+	// For TLS identities, the identifier is the certificate fingerprint.
+	if identityAuthMethod == "tls" {
+		m.CertificateFingerprint = types.StringValue(identity.Identifier)
+	} else {
+		m.CertificateFingerprint = types.StringNull()
+	}
+
 	groups, diags := common.ToStringSetType(ctx, identity.Groups)
 	respDiags.Append(diags...)
 
@@ -332,3 +485,16 @@ func (r *AuthIdentityResource) ImportState(ctx context.Context, req resource.Imp
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
 	}
 }
+
+// identityCertificate returns the identity certificate from the plan, or
+// from the configuration if the write-only attribute is used.
+func identityCertificate(ctx context.Context, config tfsdk.Config, plan AuthIdentityModel) (string, diag.Diagnostics) {
+	var certificateWO types.String
+
+	diags := config.GetAttribute(ctx, path.Root("tls_certificate_wo"), &certificateWO)
+	if diags.HasError() || certificateWO.IsNull() {
+		return plan.Certificate.ValueString(), diags
+	}
+
+	return certificateWO.ValueString(), diags
+}
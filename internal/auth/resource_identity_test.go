@@ -1,10 +1,15 @@
 package auth_test
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"strings"
 	"testing"
 
+	lxdShared "github.com/canonical/lxd/shared"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 )
 
@@ -82,6 +87,51 @@ func TestAccIdentity_tls(t *testing.T) {
 	})
 }
 
+func TestAccIdentity_tlsWriteOnly(t *testing.T) {
+	identity := acctest.GenerateName(2, "-")
+	cert1, fingerprint1 := generateCert(t)
+	cert2, fingerprint2 := generateCert(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "access_management")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccIdentity_tlsWriteOnly(identity, cert1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "name", identity),
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "auth_method", "tls"),
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "tls_certificate_fingerprint", fingerprint1),
+					// Ensure certificate is not stored.
+					resource.TestCheckNoResourceAttr("lxd_auth_identity.identity", "tls_certificate"),
+					resource.TestCheckNoResourceAttr("lxd_auth_identity.identity", "tls_certificate_wo"),
+				),
+			},
+			{
+				// Change of the certificate is detected by its fingerprint.
+				Config: acctest.Provider() + testAccIdentity_tlsWriteOnly(identity, cert2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "tls_certificate_fingerprint", fingerprint2),
+					resource.TestCheckNoResourceAttr("lxd_auth_identity.identity", "tls_certificate"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccIdentity_tlsWriteOnly(identity, cert2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "tls_certificate_fingerprint", fingerprint2),
+					resource.TestCheckResourceAttr("lxd_auth_identity.identity", "tls_certificate_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIdentity_importEmpty(t *testing.T) {
 	resourceName := "lxd_auth_identity.identity"
 
@@ -180,3 +230,35 @@ func testAccIdentity_tls(name string, groups []string) string {
 		acctest.QuoteStrings(groups),
 	)
 }
+
+func testAccIdentity_tlsWriteOnly(name string, cert string, version int) string {
+	return fmt.Sprintf(`
+resource "lxd_auth_identity" "identity" {
+  auth_method        = "tls"
+  name               = %q
+  tls_certificate_wo = <<-EOF
+%s
+EOF
+  tls_certificate_wo_version = %d
+}
+	`, name, strings.TrimRight(cert, "\n"), version)
+}
+
+func generateCert(t *testing.T) (certificate string, fingerprint string) {
+	certBytes, _, err := lxdShared.GenerateMemCert(true, lxdShared.CertOptions{AddHosts: false})
+	if err != nil {
+		t.Fatalf("Failed to generate certificate: %v", err)
+	}
+
+	certBlock, _ := pem.Decode(certBytes)
+	if certBlock == nil {
+		t.Fatal("Failed to decode generated certificate")
+	}
+
+	certX509, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse generated certificate: %v", err)
+	}
+
+	return string(certBytes), lxdShared.CertFingerprint(certX509)
+}
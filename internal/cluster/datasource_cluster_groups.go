@@ -0,0 +1,164 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type ClusterGroupsModel struct {
+	Remote types.String `tfsdk:"remote"`
+	Groups types.Map    `tfsdk:"cluster_groups"`
+}
+
+type ClusterGroupsItemModel struct {
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+	Config      types.Map    `tfsdk:"config"`
+}
+
+func NewClusterGroupsDataSource() datasource.DataSource {
+	return &ClusterGroupsDataSource{}
+}
+
+type ClusterGroupsDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func (d *ClusterGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_cluster_groups", req.ProviderTypeName)
+}
+
+func (d *ClusterGroupsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"cluster_groups": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Map of cluster groups. The map key represents a cluster group name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description: "Cluster group description",
+							Computed:    true,
+						},
+
+						"members": schema.SetAttribute{
+							Description: "Names of the cluster members in the group",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"config": schema.MapAttribute{
+							Description: "Cluster group configuration",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *ClusterGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ClusterGroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := config.Remote.ValueString()
+	server, err := d.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if !server.IsClustered() {
+		resp.Diagnostics.AddError(fmt.Sprintf("Remote %q is not clustered", remote), "Cluster groups are only available on clustered LXD servers.")
+		return
+	}
+
+	groups, err := server.GetClusterGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve cluster groups from remote %q", remote), err.Error())
+		return
+	}
+
+	clusterGroups := make(map[string]ClusterGroupsItemModel, len(groups))
+	for _, group := range groups {
+		members, diags := ToMemberSetType(ctx, group.Members)
+		resp.Diagnostics.Append(diags...)
+
+		groupConfig, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(group.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		clusterGroups[group.Name] = ClusterGroupsItemModel{
+			Description: types.StringValue(group.Description),
+			Members:     members,
+			Config:      groupConfig,
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	config.Remote = types.StringValue(remote)
+	config.Groups, diags = ToClusterGroupMapType(ctx, clusterGroups)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ToClusterGroupMapType converts map[string]ClusterGroupsItemModel into types.Map.
+func ToClusterGroupMapType(ctx context.Context, groups map[string]ClusterGroupsItemModel) (types.Map, diag.Diagnostics) {
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"description": types.StringType,
+			"members":     types.SetType{ElemType: types.StringType},
+			"config":      types.MapType{ElemType: types.StringType},
+		},
+	}
+
+	if groups == nil {
+		groups = map[string]ClusterGroupsItemModel{}
+	}
+
+	return types.MapValueFrom(ctx, objType, groups)
+}
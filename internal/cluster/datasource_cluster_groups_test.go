@@ -0,0 +1,49 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccClusterGroupsDataSource_basic(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	groupName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterGroupsDataSource_basic(groupName, members[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_cluster_groups.groups", fmt.Sprintf("cluster_groups.%s.description", groupName), "Test group"),
+					resource.TestCheckResourceAttr("data.lxd_cluster_groups.groups", fmt.Sprintf("cluster_groups.%s.members.#", groupName), "1"),
+					resource.TestCheckTypeSetElemAttr("data.lxd_cluster_groups.groups", fmt.Sprintf("cluster_groups.%s.members.*", groupName), members[0]),
+					resource.TestCheckResourceAttr("data.lxd_cluster_groups.groups", fmt.Sprintf("cluster_groups.%s.config.user.purpose", groupName), "testing"),
+					resource.TestCheckTypeSetElemAttr("data.lxd_cluster_groups.groups", "cluster_groups.default.members.*", members[0]),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterGroupsDataSource_basic(groupName string, member string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_group" "group1" {
+  name        = "%s"
+  description = "Test group"
+  members     = ["%s"]
+
+  config = {
+    "user.purpose" = "testing"
+  }
+}
+
+data "lxd_cluster_groups" "groups" {
+  depends_on = [lxd_cluster_group.group1]
+}
+	`, groupName, member)
+}
@@ -0,0 +1,484 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// ClusterGroupModel resource data model that matches the schema.
+type ClusterGroupModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+	Config      types.Map    `tfsdk:"config"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ClusterGroupResource represent LXD cluster group resource.
+type ClusterGroupResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewClusterGroupResource returns a new cluster group resource.
+func NewClusterGroupResource() resource.Resource {
+	return &ClusterGroupResource{}
+}
+
+func (r ClusterGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_group"
+}
+
+func (r ClusterGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+
+			"members": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"config": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *ClusterGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r ClusterGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ClusterGroupModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	config, diags := common.ToConfigMap(ctx, plan.Config)
+	resp.Diagnostics.Append(diags...)
+
+	members, diags := ToMemberList(ctx, plan.Members)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.Name.ValueString()
+	groupReq := api.ClusterGroupsPost{
+		Name: groupName,
+		ClusterGroupPut: api.ClusterGroupPut{
+			Description: plan.Description.ValueString(),
+			Members:     members,
+			Config:      config,
+		},
+	}
+
+	err = server.CreateClusterGroup(groupReq)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create cluster group %q", groupName), err.Error())
+		return
+	}
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ClusterGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ClusterGroupModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ClusterGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ClusterGroupModel
+	var state ClusterGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	oldName := state.Name.ValueString()
+	newName := plan.Name.ValueString()
+
+	// Rename cluster group if its name has changed.
+	if oldName != newName {
+		err := server.RenameClusterGroup(oldName, api.ClusterGroupPost{Name: newName})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to rename cluster group %q to %q", oldName, newName), err.Error())
+			return
+		}
+
+		// Ensure the renamed cluster group is tracked in the state.
+		diags = plan.TaintState(ctx, &resp.State)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	group, etag, err := server.GetClusterGroup(newName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing cluster group %q", newName), err.Error())
+		return
+	}
+
+	userConfig, diags := common.ToConfigMap(ctx, plan.Config)
+	resp.Diagnostics.Append(diags...)
+
+	members, diags := ToMemberList(ctx, plan.Members)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure removed members remain in at least one cluster group.
+	removed := slices.DeleteFunc(slices.Clone(group.Members), func(member string) bool {
+		return slices.Contains(members, member)
+	})
+
+	err = checkMembersRemoval(server, newName, removed)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove members from cluster group %q", newName), err.Error())
+		return
+	}
+
+	newGroup := api.ClusterGroupPut{
+		Description: plan.Description.ValueString(),
+		Members:     members,
+		Config:      common.MergeConfig(group.Config, userConfig, plan.ComputedKeys()),
+	}
+
+	err = server.UpdateClusterGroup(newName, newGroup, etag)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update cluster group %q", newName), err.Error())
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ClusterGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ClusterGroupModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	groupName := state.Name.ValueString()
+	group, etag, err := server.GetClusterGroup(groupName)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing cluster group %q", groupName), err.Error())
+		}
+
+		return
+	}
+
+	// Only empty cluster groups can be removed.
+	if len(group.Members) > 0 {
+		// Ensure members remain in at least one cluster group.
+		err = checkMembersRemoval(server, groupName, group.Members)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove members from cluster group %q", groupName), err.Error())
+			return
+		}
+
+		newGroup := group.Writable()
+		newGroup.Members = []string{}
+
+		err = server.UpdateClusterGroup(groupName, newGroup, etag)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove members from cluster group %q", groupName), err.Error())
+			return
+		}
+	}
+
+	err = server.DeleteClusterGroup(groupName)
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove cluster group %q", groupName), err.Error())
+	}
+}
+
+func (r ClusterGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "cluster_group",
+		RequiredFields: []string{"name"},
+	}
+
+	fields, diag := meta.ParseImportID(req.ID)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	for k, v := range fields {
+		// Cluster groups are not project specific.
+		if k == "project" {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid import ID %q", req.ID),
+				"Valid import format:\nimport lxd_cluster_group.<resource> [remote:]<name>",
+			)
+			break
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
+// TaintState marks the state with identity fields required to target the cluster group.
+func (m ClusterGroupModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
+
+	return diags
+}
+
+// SyncState fetches the server's current state for a cluster group and
+// updates the provided model. It then applies this updated model as the
+// new state in Terraform.
+func (r ClusterGroupResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m ClusterGroupModel, forgetOnNotFound bool) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	groupName := m.Name.ValueString()
+	group, _, err := server.GetClusterGroup(groupName)
+	if err != nil {
+		if forgetOnNotFound && errors.IsNotFoundError(err) {
+			tfState.RemoveResource(ctx)
+			return nil
+		}
+
+		respDiags.AddError(fmt.Sprintf("Failed to sync state for cluster group %q", groupName), err.Error())
+		return respDiags
+	}
+
+	// Extract user defined config and merge it with current config state.
+	stateConfig := common.StripConfig(group.Config, m.Config, m.ComputedKeys())
+
+	config, diags := common.ToConfigMapType(ctx, stateConfig, m.Config)
+	respDiags.Append(diags...)
+
+	members, diags := ToMemberSetType(ctx, group.Members)
+	respDiags.Append(diags...)
+
+	if respDiags.HasError() {
+		return respDiags
+	}
+
+	m.Name = types.StringValue(group.Name)
+	m.Description = types.StringValue(group.Description)
+	m.Members = members
+	m.Config = config
+
+	return tfState.Set(ctx, &m)
+}
+
+// ComputedKeys returns list of computed config keys.
+func (m ClusterGroupModel) ComputedKeys() []string {
+	return []string{}
+}
+
+// checkMembersRemoval ensures that each of the given cluster members belongs
+// to at least one cluster group other than the given one, so that it can be
+// removed from the group.
+func checkMembersRemoval(server lxd.InstanceServer, groupName string, members []string) error {
+	for _, memberName := range members {
+		member, _, err := server.GetClusterMember(memberName)
+		if err != nil {
+			return fmt.Errorf("Failed to retrieve cluster member %q: %w", memberName, err)
+		}
+
+		hasOtherGroup := slices.ContainsFunc(member.Groups, func(group string) bool {
+			return group != groupName
+		})
+
+		if !hasOtherGroup {
+			return fmt.Errorf("Cluster member %q must belong to at least one cluster group other than %q", memberName, groupName)
+		}
+	}
+
+	return nil
+}
+
+// ToMemberList converts cluster group members of type types.Set into
+// a slice of strings.
+func ToMemberList(ctx context.Context, memberSet types.Set) ([]string, diag.Diagnostics) {
+	if memberSet.IsNull() || memberSet.IsUnknown() {
+		return []string{}, nil
+	}
+
+	members := make([]string, 0, len(memberSet.Elements()))
+	diags := memberSet.ElementsAs(ctx, &members, false)
+	return members, diags
+}
+
+// ToMemberSetType converts slice of strings into cluster group members of
+// type types.Set.
+func ToMemberSetType(ctx context.Context, members []string) (types.Set, diag.Diagnostics) {
+	if len(members) == 0 {
+		// Prevent null value if slice is empty.
+		return types.SetValueMust(types.StringType, []attr.Value{}), nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, members)
+}
@@ -0,0 +1,152 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccClusterGroup_basic(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	groupName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterGroup_basic(groupName, "Test group", members[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "name", groupName),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "description", "Test group"),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("lxd_cluster_group.group1", "members.*", members[0]),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "config.%", "1"),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "config.user.purpose", "testing"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccClusterGroup_noMembers(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "name", groupName),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "description", ""),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "members.#", "0"),
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "config.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterGroup_rename(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	groupName := acctest.GenerateName(2, "-")
+	newGroupName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterGroup_basic(groupName, "", members[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "name", groupName),
+					resource.TestCheckTypeSetElemAttr("lxd_cluster_group.group1", "members.*", members[0]),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccClusterGroup_basic(newGroupName, "", members[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "name", newGroupName),
+					resource.TestCheckTypeSetElemAttr("lxd_cluster_group.group1", "members.*", members[0]),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterGroup_instanceTarget(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	groupName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterGroup_instanceTarget(groupName, members[0], instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_group.group1", "name", groupName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "target", "@"+groupName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "location", members[0]),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterGroup_importBasic(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	groupName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_cluster_group.group1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterGroup_basic(groupName, "", members[0]),
+			},
+			{
+				Config:                               acctest.Provider() + testAccClusterGroup_basic(groupName, "", members[0]),
+				ResourceName:                         resourceName,
+				ImportStateId:                        groupName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func testAccClusterGroup_basic(groupName string, description string, member string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_group" "group1" {
+  name        = "%s"
+  description = "%s"
+  members     = ["%s"]
+
+  config = {
+    "user.purpose" = "testing"
+  }
+}
+	`, groupName, description, member)
+}
+
+func testAccClusterGroup_noMembers(groupName string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_group" "group1" {
+  name    = "%s"
+  members = []
+}
+	`, groupName)
+}
+
+func testAccClusterGroup_instanceTarget(groupName string, member string, instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_group" "group1" {
+  name    = "%s"
+  members = ["%s"]
+}
+
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+  target  = "@${lxd_cluster_group.group1.name}"
+}
+	`, groupName, member, instanceName, acctest.TestImage)
+}
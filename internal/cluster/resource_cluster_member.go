@@ -0,0 +1,659 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// automaticRoles are cluster member roles that are assigned by LXD and
+// cannot be changed by the user.
+var automaticRoles = []string{
+	"database",
+	"database-leader",
+	"database-standby",
+}
+
+// Cluster member states that can be managed through the resource.
+const (
+	clusterMemberStateCreated   = "created"
+	clusterMemberStateEvacuated = "evacuated"
+)
+
+// ClusterMemberModel resource data model that matches the schema.
+type ClusterMemberModel struct {
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Roles           types.Set    `tfsdk:"roles"`
+	FailureDomain   types.String `tfsdk:"failure_domain"`
+	Groups          types.Set    `tfsdk:"groups"`
+	Config          types.Map    `tfsdk:"config"`
+	State           types.String `tfsdk:"state"`
+	EvacuationMode  types.String `tfsdk:"evacuation_mode"`
+	RemoveOnDestroy types.Bool   `tfsdk:"remove_on_destroy"`
+	Remote          types.String `tfsdk:"remote"`
+
+	// Computed.
+	URL          types.String `tfsdk:"url"`
+	Architecture types.String `tfsdk:"architecture"`
+	Status       types.String `tfsdk:"status"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ClusterMemberResource represent LXD cluster member resource.
+type ClusterMemberResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewClusterMemberResource returns a new cluster member resource.
+func NewClusterMemberResource() resource.Resource {
+	return &ClusterMemberResource{}
+}
+
+func (r ClusterMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_member"
+}
+
+func (r ClusterMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"roles": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.NoneOf(automaticRoles...),
+					),
+				},
+			},
+
+			"failure_domain": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"groups": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"config": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(clusterMemberStateCreated, clusterMemberStateEvacuated),
+				},
+			},
+
+			"evacuation_mode": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("stop", "migrate", "live-migrate"),
+				},
+			},
+
+			"remove_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed.
+
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"architecture": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *ClusterMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+// Create brings an existing cluster member under Terraform management.
+// Cluster members cannot be created by the provider, as they have to join
+// the cluster on their own.
+func (r ClusterMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ClusterMemberModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if !server.IsClustered() {
+		resp.Diagnostics.AddError(fmt.Sprintf("Remote %q is not clustered", remote), "Cluster members can only be managed on a clustered LXD server.")
+		return
+	}
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = r.updateClusterMember(ctx, server, plan, types.MapNull(types.StringType))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = r.updateClusterMemberState(ctx, server, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ClusterMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ClusterMemberModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ClusterMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ClusterMemberModel
+	var state ClusterMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	diags = r.updateClusterMember(ctx, server, plan, state.Config)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = r.updateClusterMemberState(ctx, server, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the cluster member from the cluster only if explicitly
+// requested. Otherwise, the member is only removed from the Terraform state.
+func (r ClusterMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ClusterMemberModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	memberName := state.Name.ValueString()
+	err = server.DeleteClusterMember(memberName, false)
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove cluster member %q", memberName), err.Error())
+	}
+}
+
+func (r ClusterMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "cluster_member",
+		RequiredFields: []string{"name"},
+	}
+
+	fields, diag := meta.ParseImportID(req.ID)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	for k, v := range fields {
+		// Cluster members are not project specific.
+		if k == "project" {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid import ID %q", req.ID),
+				"Valid import format:\nimport lxd_cluster_member.<resource> [remote:]<name>",
+			)
+			break
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remove_on_destroy"), false)...)
+}
+
+// updateClusterMember applies the configuration from the model to the
+// cluster member. Description, roles, failure domain, groups, and config
+// that are not set in the model are left unchanged. Only config keys that
+// are set in the model or were previously managed (present in the old
+// config) are modified.
+func (r ClusterMemberResource) updateClusterMember(ctx context.Context, server lxd.InstanceServer, m ClusterMemberModel, oldConfig types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	memberName := m.Name.ValueString()
+	member, etag, err := server.GetClusterMember(memberName)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to retrieve cluster member %q", memberName), err.Error())
+		return diags
+	}
+
+	newMember := member.Writable()
+
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		newMember.Description = m.Description.ValueString()
+	}
+
+	if !m.Config.IsNull() && !m.Config.IsUnknown() {
+		userConfig, diags := common.ToConfigMap(ctx, m.Config)
+		if diags.HasError() {
+			return diags
+		}
+
+		managedConfig, diags := common.ToConfigMap(ctx, oldConfig)
+		if diags.HasError() {
+			return diags
+		}
+
+		newConfig := maps.Clone(member.Config)
+		if newConfig == nil {
+			newConfig = make(map[string]string, len(userConfig))
+		}
+
+		// Remove previously managed keys that are no longer set.
+		for k := range managedConfig {
+			_, ok := userConfig[k]
+			if !ok {
+				delete(newConfig, k)
+			}
+		}
+
+		// Empty values in LXD configuration are considered unset.
+		for k, v := range userConfig {
+			if v == "" {
+				delete(newConfig, k)
+			} else {
+				newConfig[k] = v
+			}
+		}
+
+		newMember.Config = newConfig
+	}
+
+	if !m.Roles.IsNull() && !m.Roles.IsUnknown() {
+		roles := make([]string, 0, len(m.Roles.Elements()))
+		diags.Append(m.Roles.ElementsAs(ctx, &roles, false)...)
+
+		// Automatic roles must be retained, as they are managed by LXD.
+		for _, role := range member.Roles {
+			if slices.Contains(automaticRoles, role) {
+				roles = append(roles, role)
+			}
+		}
+
+		newMember.Roles = roles
+	}
+
+	if !m.Groups.IsNull() && !m.Groups.IsUnknown() {
+		groups := make([]string, 0, len(m.Groups.Elements()))
+		diags.Append(m.Groups.ElementsAs(ctx, &groups, false)...)
+		newMember.Groups = groups
+	}
+
+	if !m.FailureDomain.IsNull() && !m.FailureDomain.IsUnknown() {
+		newMember.FailureDomain = m.FailureDomain.ValueString()
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	err = server.UpdateClusterMember(memberName, newMember, etag)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update cluster member %q", memberName), err.Error())
+		return diags
+	}
+
+	return diags
+}
+
+// updateClusterMemberState evacuates or restores the cluster member if its
+// current state differs from the one in the model, and waits for the
+// operation to complete. The state is left unchanged if not set.
+func (r ClusterMemberResource) updateClusterMemberState(ctx context.Context, server lxd.InstanceServer, m ClusterMemberModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.State.IsNull() || m.State.IsUnknown() {
+		return nil
+	}
+
+	memberName := m.Name.ValueString()
+	member, _, err := server.GetClusterMember(memberName)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to retrieve cluster member %q", memberName), err.Error())
+		return diags
+	}
+
+	state := m.State.ValueString()
+	if state == toClusterMemberState(member.Status) {
+		return nil
+	}
+
+	req := api.ClusterMemberStatePost{}
+	if state == clusterMemberStateEvacuated {
+		req.Action = "evacuate"
+		req.Mode = m.EvacuationMode.ValueString()
+	} else {
+		req.Action = "restore"
+	}
+
+	op, err := server.UpdateClusterMemberState(memberName, req)
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to %s cluster member %q", req.Action, memberName), err.Error())
+		return diags
+	}
+
+	return diags
+}
+
+// TaintState marks the state with identity fields required to target the cluster member.
+func (m ClusterMemberModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remove_on_destroy"), m.RemoveOnDestroy.ValueBool())...)
+
+	return diags
+}
+
+// SyncState fetches the server's current state for a cluster member and
+// updates the provided model. It then applies this updated model as the
+// new state in Terraform.
+func (r ClusterMemberResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m ClusterMemberModel, forgetOnNotFound bool) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	memberName := m.Name.ValueString()
+	member, _, err := server.GetClusterMember(memberName)
+	if err != nil {
+		if forgetOnNotFound && errors.IsNotFoundError(err) {
+			tfState.RemoveResource(ctx)
+			return nil
+		}
+
+		respDiags.AddError(fmt.Sprintf("Failed to sync state for cluster member %q", memberName), err.Error())
+		return respDiags
+	}
+
+	// Exclude automatic roles, as they cannot be managed.
+	roles := make([]string, 0, len(member.Roles))
+	for _, role := range member.Roles {
+		if !slices.Contains(automaticRoles, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	// Track only the config keys that are managed by the resource. If
+	// config is not managed, the whole member config is reported.
+	var stateConfig map[string]*string
+	if m.Config.IsNull() || m.Config.IsUnknown() {
+		stateConfig = common.StripConfig(member.Config, m.Config, m.ComputedKeys())
+	} else {
+		stateConfig = managedClusterMemberConfig(ctx, member.Config, m.Config)
+	}
+
+	config, diags := common.ToConfigMapType(ctx, stateConfig, m.Config)
+	respDiags.Append(diags...)
+
+	m.Roles, diags = types.SetValueFrom(ctx, types.StringType, roles)
+	respDiags.Append(diags...)
+
+	groups := make([]string, 0, len(member.Groups))
+	groups = append(groups, member.Groups...)
+
+	m.Groups, diags = types.SetValueFrom(ctx, types.StringType, groups)
+	respDiags.Append(diags...)
+
+	if respDiags.HasError() {
+		return respDiags
+	}
+
+	m.Name = types.StringValue(member.ServerName)
+	m.Description = types.StringValue(member.Description)
+	m.FailureDomain = types.StringValue(member.FailureDomain)
+	m.Config = config
+	m.URL = types.StringValue(member.URL)
+	m.Architecture = types.StringValue(member.Architecture)
+	m.Status = types.StringValue(member.Status)
+	m.State = types.StringValue(toClusterMemberState(member.Status))
+
+	return tfState.Set(ctx, &m)
+}
+
+// ComputedKeys returns list of computed config keys.
+func (m ClusterMemberModel) ComputedKeys() []string {
+	return []string{}
+}
+
+// managedClusterMemberConfig returns the entries of the cluster member config
+// whose keys are present in the model config. Keys that are missing from the
+// cluster member are omitted, so that they show up as changes in the plan.
+func managedClusterMemberConfig(ctx context.Context, memberConfig map[string]string, modelConfig types.Map) map[string]*string {
+	usrConfig := map[string]*string{}
+	_ = modelConfig.ElementsAs(ctx, &usrConfig, false)
+
+	config := make(map[string]*string, len(usrConfig))
+	for k := range usrConfig {
+		v, ok := memberConfig[k]
+		if ok && v != "" {
+			config[k] = &v
+		}
+	}
+
+	return config
+}
+
+// toClusterMemberState converts the cluster member status into the
+// state managed by the resource.
+func toClusterMemberState(status string) string {
+	if status == "Evacuated" {
+		return clusterMemberStateEvacuated
+	}
+
+	return clusterMemberStateCreated
+}
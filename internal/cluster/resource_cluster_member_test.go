@@ -0,0 +1,141 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccClusterMember_basic(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	memberName := members[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterMember_basic(memberName, "Managed member", "rack1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "name", memberName),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "description", "Managed member"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "failure_domain", "rack1"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "config.%", "1"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "config.user.rack", "rack1"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "remove_on_destroy", "false"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "status", "Online"),
+					resource.TestCheckResourceAttrSet("lxd_cluster_member.member1", "url"),
+					resource.TestCheckResourceAttrSet("lxd_cluster_member.member1", "architecture"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr("lxd_cluster_member.member1", "groups.*", "default"),
+				),
+			},
+			{
+				// Ensure unset description and config are left unchanged.
+				Config: acctest.Provider() + testAccClusterMember_name(memberName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "name", memberName),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "description", "Managed member"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "failure_domain", "rack1"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "config.%", "1"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "config.user.rack", "rack1"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccClusterMember_basic(memberName, "", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "name", memberName),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "description", ""),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "failure_domain", "default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterMember_importBasic(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 1)
+	memberName := members[0]
+	resourceName := "lxd_cluster_member.member1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterMember_basic(memberName, "", "default"),
+			},
+			{
+				Config:                               acctest.Provider() + testAccClusterMember_basic(memberName, "", "default"),
+				ResourceName:                         resourceName,
+				ImportStateId:                        memberName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccClusterMember_evacuate(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 2)
+	memberName := members[1]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccClusterMember_state(memberName, "evacuated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "name", memberName),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "state", "evacuated"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "status", "Evacuated"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccClusterMember_state(memberName, "created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "name", memberName),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "state", "created"),
+					resource.TestCheckResourceAttr("lxd_cluster_member.member1", "status", "Online"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterMember_basic(memberName string, description string, failureDomain string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_member" "member1" {
+  name           = "%s"
+  description    = "%s"
+  failure_domain = "%s"
+  groups         = ["default"]
+
+  config = {
+    "user.rack" = "%s"
+  }
+}
+	`, memberName, description, failureDomain, failureDomain)
+}
+
+func testAccClusterMember_name(memberName string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_member" "member1" {
+  name = "%s"
+}
+	`, memberName)
+}
+
+func testAccClusterMember_state(memberName string, state string) string {
+	return fmt.Sprintf(`
+resource "lxd_cluster_member" "member1" {
+  name            = "%s"
+  state           = "%s"
+  evacuation_mode = "stop"
+}
+	`, memberName, state)
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExpiryValidator ensures the expiry is either empty or a valid RFC 3339
+// timestamp.
+type ExpiryValidator struct{}
+
+func (v ExpiryValidator) Description(ctx context.Context) string {
+	return "Attribute value must be an empty string or a valid RFC 3339 timestamp, for example \"2030-01-01T00:00:00Z\"."
+}
+
+func (v ExpiryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ExpiryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := ToExpiryTime(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid expiry", v.Description(ctx))
+	}
+}
+
+// ToExpiryTime converts the given RFC 3339 timestamp into the expiry time.
+// Empty value is converted into zero time, which represents no expiry.
+func ToExpiryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// FromExpiryTime converts the given expiry time into an RFC 3339 timestamp.
+// Zero time is converted into an empty value.
+func FromExpiryTime(expiry time.Time) string {
+	if expiry.IsZero() {
+		return ""
+	}
+
+	return expiry.UTC().Format(time.RFC3339)
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -215,3 +216,30 @@ func toAbsFilePath(path string) (string, error) {
 
 	return targetPath, nil
 }
+
+// ContextWriteSeeker wraps an io.WriteSeeker and fails all writes once the
+// context is done. Downloads performed by the LXD client cannot be cancelled
+// with a context, therefore the wrapper is used to abort them instead.
+type ContextWriteSeeker struct {
+	io.WriteSeeker
+
+	ctx context.Context
+}
+
+// NewContextWriteSeeker returns a new [ContextWriteSeeker] writing into w.
+func NewContextWriteSeeker(ctx context.Context, w io.WriteSeeker) *ContextWriteSeeker {
+	return &ContextWriteSeeker{
+		WriteSeeker: w,
+		ctx:         ctx,
+	}
+}
+
+// Write writes p into the underlying writer, unless the context is done.
+func (w *ContextWriteSeeker) Write(p []byte) (int, error) {
+	err := w.ctx.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	return w.WriteSeeker.Write(p)
+}
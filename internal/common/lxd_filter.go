@@ -0,0 +1,86 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/filter"
+)
+
+// ListQuery describes a recursive listing of LXD entities.
+type ListQuery struct {
+	// Project from which the entities are listed. Ignored when
+	// AllProjects is set.
+	Project string
+
+	// AllProjects lists entities from all projects.
+	AllProjects bool
+
+	// Recursion level of the request.
+	Recursion int
+
+	// Filter is an LXD API filter expression, for example
+	// "status eq Running and config.user.role eq web".
+	Filter string
+}
+
+// QueryList lists the entities on the given API path (e.g. "/1.0/instances")
+// and decodes the response metadata into the target. The filter expression is
+// evaluated by the LXD server, therefore this function must only be used
+// for the API endpoints that support the "filter" parameter.
+//
+// The generic LXD client functions accept only "key=value" filters, hence
+// the raw query is used to support the complete filter syntax.
+func QueryList(server lxd.InstanceServer, apiPath string, query ListQuery, target any) error {
+	values := url.Values{}
+	values.Set("recursion", strconv.Itoa(query.Recursion))
+
+	if query.AllProjects {
+		values.Set("all-projects", "true")
+	} else if query.Project != "" {
+		values.Set("project", query.Project)
+	}
+
+	if strings.TrimSpace(query.Filter) != "" {
+		values.Set("filter", query.Filter)
+	}
+
+	resp, _, err := server.RawQuery("GET", apiPath+"?"+values.Encode(), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return resp.MetadataAsStruct(target)
+}
+
+// FilterObjects returns objects that match the given LXD API filter
+// expression. It is used for the API endpoints that do not support the
+// "filter" parameter, so that the same syntax can be used across all
+// entities. An empty expression matches all objects.
+func FilterObjects[T any](objects []T, expr string) ([]T, error) {
+	if strings.TrimSpace(expr) == "" {
+		return objects, nil
+	}
+
+	clauses, err := filter.Parse(expr, filter.QueryOperatorSet())
+	if err != nil {
+		return nil, fmt.Errorf("Invalid filter %q: %w", expr, err)
+	}
+
+	result := make([]T, 0, len(objects))
+	for _, obj := range objects {
+		match, err := filter.Match(obj, *clauses)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to apply filter %q: %w", expr, err)
+		}
+
+		if match {
+			result = append(result, obj)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,17 @@
+package common
+
+import (
+	"strings"
+)
+
+// ParseImageRef splits the image reference in format "[remote:]image" into
+// the remote name and the image alias or fingerprint. The remote is empty
+// if the reference does not contain a colon.
+func ParseImageRef(ref string) (remote string, image string) {
+	before, after, found := strings.Cut(ref, ":")
+	if !found {
+		return "", ref
+	}
+
+	return before, after
+}
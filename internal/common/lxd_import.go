@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,6 +15,10 @@ type ImportMetadata struct {
 	ResourceName   string
 	RequiredFields []string
 	AllowedOptions []string
+
+	// TrailingPath indicates that the last required field is an absolute
+	// path, which may contain slashes.
+	TrailingPath bool
 }
 
 // ParseImportID parses remote name, project name, required fields, and other
@@ -22,6 +27,8 @@ type ImportMetadata struct {
 // Remote is separated using colun "[remote:]". Project and other required
 // fields are separated using slash "[project/]rf". If there are multiple
 // required fields, first slash becomes mandatory "[project]/rf1/rf2".
+// If the last required field is a trailing path, the remainder of the ID
+// is used as its value "[project]/rf1/path/to/file".
 // Options are separated using comma "rf[,opt1=value][,opt2=value]".
 //
 // Expected format:
@@ -46,7 +53,7 @@ func (m ImportMetadata) ParseImportID(importID string) (map[string]string, diag.
 	parts := strings.Split(importID, ",")
 
 	// Extract fields (including project and remote) from first part.
-	result, err := processFields(parts[0], m.RequiredFields)
+	result, err := processFields(parts[0], m.RequiredFields, m.TrailingPath)
 	if err != nil {
 		return nil, newImportIDError(m, importID, err)
 	}
@@ -68,7 +75,7 @@ func (m ImportMetadata) ParseImportID(importID string) (map[string]string, diag.
 
 // processFields convert the mandatory part of the import ID into remote,
 // project, and any number of provided required fields.
-func processFields(id string, requiredFields []string) (map[string]string, error) {
+func processFields(id string, requiredFields []string, trailingPath bool) (map[string]string, error) {
 	result := make(map[string]string)
 
 	// Check for remote if import ID contains colon.
@@ -83,8 +90,14 @@ func processFields(id string, requiredFields []string) (map[string]string, error
 		id = strings.TrimPrefix(id, remote+":")
 	}
 
-	// Split the remaining id into project and required fields.
-	parts := strings.Split(id, "/")
+	// Split the remaining id into project and required fields. A trailing
+	// path consumes the rest of the id, including any slashes.
+	n := -1
+	if trailingPath {
+		n = len(requiredFields) + 1
+	}
+
+	parts := strings.SplitN(id, "/", n)
 	if len(parts) > 1 {
 		project := parts[0]
 		if project != "" {
@@ -109,6 +122,10 @@ func processFields(id string, requiredFields []string) (map[string]string, error
 			return nil, fmt.Errorf("Import ID requires non-empty value for %q", key)
 		}
 
+		if trailingPath && i == len(requiredFields)-1 {
+			val = path.Clean("/" + val)
+		}
+
 		result[key] = val
 	}
 
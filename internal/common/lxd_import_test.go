@@ -13,6 +13,7 @@ type importMetadataTest struct {
 	ResourceName string
 	Fields       []string
 	Options      []string
+	TrailingPath bool
 	Result       map[string]string
 	ErrorString  string
 }
@@ -23,6 +24,7 @@ func runTest(t *testing.T, test importMetadataTest) {
 			ResourceName:   test.ResourceName,
 			RequiredFields: test.Fields,
 			AllowedOptions: test.Options,
+			TrailingPath:   test.TrailingPath,
 		}
 
 		result, diag := meta.ParseImportID(test.ImportID)
@@ -174,6 +176,48 @@ func TestSplitImportID_RequiredFields(t *testing.T) {
 	}
 }
 
+func TestSplitImportID_TrailingPath(t *testing.T) {
+	tests := []importMetadataTest{
+		{
+			ImportID: "/c1/etc/hosts",
+			Result: map[string]string{
+				"instance":    "c1",
+				"target_path": "/etc/hosts",
+			},
+		},
+		{
+			ImportID: "remote:project/c1/etc/hosts",
+			Result: map[string]string{
+				"instance":    "c1",
+				"target_path": "/etc/hosts",
+				"project":     "project",
+				"remote":      "remote",
+			},
+		},
+		{
+			ImportID: "/c1//etc/hosts",
+			Result: map[string]string{
+				"instance":    "c1",
+				"target_path": "/etc/hosts",
+			},
+		},
+		{
+			ImportID:    "/c1/",
+			ErrorString: "Import ID requires non-empty value for \"target_path\".",
+		},
+		{
+			ImportID:    "c1",
+			ErrorString: "Import ID does not contain all required fields: [instance, target_path].",
+		},
+	}
+
+	for _, test := range tests {
+		test.Fields = []string{"instance", "target_path"}
+		test.TrailingPath = true
+		runTest(t, test)
+	}
+}
+
 func TestSplitImportID_AllowedOption(t *testing.T) {
 	tests := []importMetadataTest{
 		{
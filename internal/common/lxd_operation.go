@@ -0,0 +1,27 @@
+package common
+
+import (
+	"context"
+
+	lxd "github.com/canonical/lxd/client"
+)
+
+// WaitRemoteOperation waits for the given remote operation to complete. Unlike
+// a local operation, a remote operation cannot be awaited with a context.
+// Therefore, if the context is done before the operation completes, the
+// operation on the target server is cancelled and the context error is
+// returned.
+func WaitRemoteOperation(ctx context.Context, op lxd.RemoteOperation) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- op.Wait()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		_ = op.CancelTarget()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// ModifyPlanProject sets the planned "project" attribute to the default
+// project of the resource's remote if the project is not configured.
+func ModifyPlanProject(ctx context.Context, provider *provider_config.LxdProviderConfig, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ModifyPlanProjectAt(ctx, provider, req, resp, path.Root("project"), path.Root("remote"))
+}
+
+// ModifyPlanProjectAt sets the planned project attribute to the default
+// project of the remote referenced by the remote attribute if the project is
+// not configured. This way, the effective project is shown in the plan.
+//
+// Project attributes require resource replacement, therefore the replacement
+// is requested only if the effective project differs from the one in state.
+func ModifyPlanProjectAt(ctx context.Context, provider *provider_config.LxdProviderConfig, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, projectPath path.Path, remotePath path.Path) {
+	if req.Plan.Raw.IsNull() || provider == nil {
+		// Nothing to do on destroy or if provider is not yet configured.
+		return
+	}
+
+	var project types.String
+	var remote types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, projectPath, &project)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, remotePath, &remote)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Project is either configured or cannot be determined yet.
+	if !project.IsNull() || remote.IsUnknown() {
+		return
+	}
+
+	effectiveProject := provider.Project(remote.ValueString())
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, projectPath, effectiveProject)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateProject types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, projectPath, &stateProject)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if stateProject.ValueString() != effectiveProject {
+		resp.RequiresReplace.Append(projectPath)
+		return
+	}
+
+	// Project was unknown before the effective project was determined.
+	// Drop the replacement requested by the plan modifiers, as the
+	// project did not change.
+	requiresReplace := make(path.Paths, 0, len(resp.RequiresReplace))
+	for _, p := range resp.RequiresReplace {
+		if !p.Equal(projectPath) {
+			requiresReplace = append(requiresReplace, p)
+		}
+	}
+
+	resp.RequiresReplace = requiresReplace
+}
@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// byteSizeSuffixes contains the IEC suffixes accepted by LXD, ordered from
+// the largest to the smallest unit.
+var byteSizeSuffixes = []string{"EiB", "PiB", "TiB", "GiB", "MiB", "KiB"}
+
+// BytesToLxdFunction converts bytes into an LXD byte size string.
+type BytesToLxdFunction struct{}
+
+// NewBytesToLxdFunction returns a new [BytesToLxdFunction].
+func NewBytesToLxdFunction() function.Function {
+	return &BytesToLxdFunction{}
+}
+
+func (f BytesToLxdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bytes_to_lxd"
+}
+
+func (f BytesToLxdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert bytes into an LXD size",
+		Description: "Converts the number of bytes into a size in the LXD format using the largest IEC unit that represents the value exactly, for example 1073741824 becomes \"1GiB\".",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "bytes",
+				Description: "Number of bytes.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f BytesToLxdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bytes int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
+	if resp.Error != nil {
+		return
+	}
+
+	if bytes < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid number of bytes %d: Value must not be negative", bytes))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, toByteSizeString(bytes)))
+}
+
+// toByteSizeString returns the size using the largest IEC unit that
+// represents the value exactly, so that the result can be parsed back
+// into the same number of bytes.
+func toByteSizeString(bytes int64) string {
+	if bytes == 0 {
+		return "0B"
+	}
+
+	for i, suffix := range byteSizeSuffixes {
+		unit := int64(1) << (10 * (len(byteSizeSuffixes) - i))
+		if bytes%unit == 0 {
+			return fmt.Sprintf("%d%s", bytes/unit, suffix)
+		}
+	}
+
+	return fmt.Sprintf("%dB", bytes)
+}
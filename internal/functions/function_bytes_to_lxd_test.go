@@ -0,0 +1,59 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionBytesToLxd(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "zero" {
+  value = provider::lxd::bytes_to_lxd(0)
+}
+
+output "bytes" {
+  value = provider::lxd::bytes_to_lxd(1000)
+}
+
+output "gib" {
+  value = provider::lxd::bytes_to_lxd(1073741824)
+}
+
+output "mib" {
+  value = provider::lxd::bytes_to_lxd(1610612736)
+}
+
+output "round_trip" {
+  value = provider::lxd::lxd_to_bytes(provider::lxd::bytes_to_lxd(5368709120))
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("zero", "0B"),
+					resource.TestCheckOutput("bytes", "1000B"),
+					resource.TestCheckOutput("gib", "1GiB"),
+					resource.TestCheckOutput("mib", "1536MiB"),
+					resource.TestCheckOutput("round_trip", "5368709120"),
+				),
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::lxd::bytes_to_lxd(-1)
+}
+				`,
+				ExpectError: regexp.MustCompile(`Value must not be negative`),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	lxdShared "github.com/canonical/lxd/shared"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/truststore"
+)
+
+// CertFingerprintFunction computes the fingerprint of a certificate.
+type CertFingerprintFunction struct{}
+
+// NewCertFingerprintFunction returns a new [CertFingerprintFunction].
+func NewCertFingerprintFunction() function.Function {
+	return &CertFingerprintFunction{}
+}
+
+func (f CertFingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cert_fingerprint"
+}
+
+func (f CertFingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute a certificate fingerprint",
+		Description: "Computes the SHA-256 fingerprint of a PEM encoded x509 certificate, the same way as LXD identifies certificates in the trust store.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pem",
+				Description: "PEM encoded x509 certificate.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f CertFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pem string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &pem))
+	if resp.Error != nil {
+		return
+	}
+
+	cert, err := truststore.ParseCertX509([]byte(pem))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Failed to parse certificate: %v", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, lxdShared.CertFingerprint(cert)))
+}
@@ -0,0 +1,61 @@
+package functions_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	lxdShared "github.com/canonical/lxd/shared"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionCertFingerprint(t *testing.T) {
+	certBytes, _, err := lxdShared.GenerateMemCert(true, lxdShared.CertOptions{AddHosts: false})
+	if err != nil {
+		t.Fatalf("Failed to generate certificate: %v", err)
+	}
+
+	certBlock, _ := pem.Decode(certBytes)
+	if certBlock == nil {
+		t.Fatal("Failed to decode generated certificate")
+	}
+
+	certX509, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse generated certificate: %v", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testFunctionCertFingerprint(string(certBytes)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fingerprint", lxdShared.CertFingerprint(certX509)),
+				),
+			},
+			{
+				Config:      testFunctionCertFingerprint("invalid"),
+				ExpectError: regexp.MustCompile(`Failed to parse certificate`),
+			},
+		},
+	})
+}
+
+func testFunctionCertFingerprint(cert string) string {
+	return fmt.Sprintf(`
+output "fingerprint" {
+  value = provider::lxd::cert_fingerprint(<<-EOT
+%s
+  EOT
+  )
+}
+	`, cert)
+}
@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/units"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// LxdToBytesFunction converts an LXD byte size string into bytes.
+type LxdToBytesFunction struct{}
+
+// NewLxdToBytesFunction returns a new [LxdToBytesFunction].
+func NewLxdToBytesFunction() function.Function {
+	return &LxdToBytesFunction{}
+}
+
+func (f LxdToBytesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "lxd_to_bytes"
+}
+
+func (f LxdToBytesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert an LXD size into bytes",
+		Description: "Converts a size in the LXD format, for example \"1GiB\" or \"500MB\", into the number of bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "Size in the LXD format.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f LxdToBytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	bytes, err := units.ParseByteSizeString(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid size %q: %v", size, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, bytes))
+}
@@ -0,0 +1,49 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionLxdToBytes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "gib" {
+  value = provider::lxd::lxd_to_bytes("1GiB")
+}
+
+output "mb" {
+  value = provider::lxd::lxd_to_bytes("500MB")
+}
+
+output "bytes" {
+  value = provider::lxd::lxd_to_bytes("1024")
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("gib", "1073741824"),
+					resource.TestCheckOutput("mb", "500000000"),
+					resource.TestCheckOutput("bytes", "1024"),
+				),
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::lxd::lxd_to_bytes("1 gigabyte")
+}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid size "1 gigabyte"`),
+			},
+		},
+	})
+}
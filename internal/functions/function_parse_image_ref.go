@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+)
+
+// ParseImageRefFunction splits an image reference into remote and image.
+type ParseImageRefFunction struct{}
+
+// NewParseImageRefFunction returns a new [ParseImageRefFunction].
+func NewParseImageRefFunction() function.Function {
+	return &ParseImageRefFunction{}
+}
+
+func (f ParseImageRefFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_image_ref"
+}
+
+func (f ParseImageRefFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an image reference",
+		Description: "Splits an image reference in format \"[remote:]image\" into the remote name and the image alias or fingerprint. The remote is empty if the reference does not contain a remote.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ref",
+				Description: "Image reference, for example \"ubuntu:24.04\".",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: imageRefAttrTypes,
+		},
+	}
+}
+
+func (f ParseImageRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ref))
+	if resp.Error != nil {
+		return
+	}
+
+	remote, image := common.ParseImageRef(ref)
+
+	result, diags := types.ObjectValue(imageRefAttrTypes, map[string]attr.Value{
+		"remote": types.StringValue(remote),
+		"image":  types.StringValue(image),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+var imageRefAttrTypes = map[string]attr.Type{
+	"remote": types.StringType,
+	"image":  types.StringType,
+}
@@ -0,0 +1,52 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionParseImageRef(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "with_remote" {
+  value = provider::lxd::parse_image_ref("ubuntu:24.04")
+}
+
+output "without_remote" {
+  value = provider::lxd::parse_image_ref("alpine/edge")
+}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("with_remote", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"remote": knownvalue.StringExact("ubuntu"),
+						"image":  knownvalue.StringExact("24.04"),
+					})),
+					statecheck.ExpectKnownOutputValue("without_remote", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"remote": knownvalue.StringExact(""),
+						"image":  knownvalue.StringExact("alpine/edge"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::lxd::parse_image_ref(null)
+}
+				`,
+				ExpectError: regexp.MustCompile(`must not be null`),
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+)
+
+// ParseImportIDFunction parses an import ID into its fields.
+type ParseImportIDFunction struct{}
+
+// NewParseImportIDFunction returns a new [ParseImportIDFunction].
+func NewParseImportIDFunction() function.Function {
+	return &ParseImportIDFunction{}
+}
+
+func (f ParseImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f ParseImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an import ID",
+		Description: "Parses an import ID in format \"[remote:][project/]field1[/fieldN][,option=value]\" into a map of remote, project, required fields, and options, the same way as resource imports do.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Import ID, for example \"local:default/instance1\".",
+			},
+			function.ListParameter{
+				Name:        "required_fields",
+				Description: "Names of the required fields in order of appearance, for example [\"name\"].",
+				ElementType: types.StringType,
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "allowed_options",
+			Description: "Names of the options that are allowed in the import ID.",
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f ParseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var requiredFields []string
+	var allowedOptions []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id, &requiredFields, &allowedOptions))
+	if resp.Error != nil {
+		return
+	}
+
+	meta := common.ImportMetadata{
+		RequiredFields: requiredFields,
+		AllowedOptions: allowedOptions,
+	}
+
+	fields, diag := meta.ParseImportID(id)
+	if diag != nil {
+		// Omit the resource specific import format from the error.
+		reason, _, _ := strings.Cut(diag.Detail(), "\n\n")
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s: %s", diag.Summary(), reason))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fields))
+}
@@ -0,0 +1,62 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionParseImportID(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "name" {
+  value = provider::lxd::parse_import_id("local:proj/inst1", ["name"])
+}
+
+output "options" {
+  value = provider::lxd::parse_import_id("pool1/vol1,content_type=block", ["pool", "name"], "content_type")
+}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("name", knownvalue.MapExact(map[string]knownvalue.Check{
+						"remote":  knownvalue.StringExact("local"),
+						"project": knownvalue.StringExact("proj"),
+						"name":    knownvalue.StringExact("inst1"),
+					})),
+					statecheck.ExpectKnownOutputValue("options", knownvalue.MapExact(map[string]knownvalue.Check{
+						"pool":         knownvalue.StringExact("pool1"),
+						"name":         knownvalue.StringExact("vol1"),
+						"content_type": knownvalue.StringExact("block"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::lxd::parse_import_id("inst1,image=jammy", ["name"])
+}
+				`,
+				ExpectError: regexp.MustCompile(`Import ID contains unexpected option "image"`),
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::lxd::parse_import_id("proj/", ["name"])
+}
+				`,
+				ExpectError: regexp.MustCompile(`Import ID requires non-empty value for "name"`),
+			},
+		},
+	})
+}
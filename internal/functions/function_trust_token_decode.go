@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lxdShared "github.com/canonical/lxd/shared"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TrustTokenDecodeFunction decodes an LXD trust token.
+type TrustTokenDecodeFunction struct{}
+
+// NewTrustTokenDecodeFunction returns a new [TrustTokenDecodeFunction].
+func NewTrustTokenDecodeFunction() function.Function {
+	return &TrustTokenDecodeFunction{}
+}
+
+func (f TrustTokenDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trust_token_decode"
+}
+
+func (f TrustTokenDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a trust token",
+		Description: "Decodes an LXD trust token into the client name, server certificate fingerprint, server addresses, secret, and expiry time. The expiry time is empty if the token does not expire.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "token",
+				Description: "Trust token issued by the LXD server.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: trustTokenAttrTypes,
+		},
+	}
+}
+
+func (f TrustTokenDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &token))
+	if resp.Error != nil {
+		return
+	}
+
+	decoded, err := lxdShared.CertificateTokenDecode(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Failed to decode trust token: %v", err))
+		return
+	}
+
+	addresses, diags := types.ListValueFrom(ctx, types.StringType, decoded.Addresses)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	expiresAt := ""
+	if !decoded.ExpiresAt.IsZero() {
+		expiresAt = decoded.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	result, diags := types.ObjectValue(trustTokenAttrTypes, map[string]attr.Value{
+		"client_name": types.StringValue(decoded.ClientName),
+		"fingerprint": types.StringValue(decoded.Fingerprint),
+		"addresses":   addresses,
+		"secret":      types.StringValue(decoded.Secret),
+		"expires_at":  types.StringValue(expiresAt),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+var trustTokenAttrTypes = map[string]attr.Type{
+	"client_name": types.StringType,
+	"fingerprint": types.StringType,
+	"addresses":   types.ListType{ElemType: types.StringType},
+	"secret":      types.StringType,
+	"expires_at":  types.StringType,
+}
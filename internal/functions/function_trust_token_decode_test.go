@@ -0,0 +1,70 @@
+package functions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestFunctionTrustTokenDecode(t *testing.T) {
+	token := api.CertificateAddToken{
+		ClientName:  "client1",
+		Fingerprint: "2f4b0f5e7c3f1a9d",
+		Addresses:   []string{"10.0.0.1:8443", "[fd42::1]:8443"},
+		Secret:      "s3cr3t",
+		ExpiresAt:   time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	noExpiryToken := token
+	noExpiryToken.ExpiresAt = time.Time{}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testFunctionTrustTokenDecode(token.String(), noExpiryToken.String()),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("token", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"client_name": knownvalue.StringExact("client1"),
+						"fingerprint": knownvalue.StringExact("2f4b0f5e7c3f1a9d"),
+						"addresses": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("10.0.0.1:8443"),
+							knownvalue.StringExact("[fd42::1]:8443"),
+						}),
+						"secret":     knownvalue.StringExact("s3cr3t"),
+						"expires_at": knownvalue.StringExact("2030-01-02T03:04:05Z"),
+					})),
+					statecheck.ExpectKnownOutputValueAtPath("no_expiry", tfjsonpath.New("expires_at"), knownvalue.StringExact("")),
+				},
+			},
+			{
+				Config:      testFunctionTrustTokenDecode("invalid", "invalid"),
+				ExpectError: regexp.MustCompile(`Failed to decode trust token`),
+			},
+		},
+	})
+}
+
+func testFunctionTrustTokenDecode(token string, noExpiryToken string) string {
+	return fmt.Sprintf(`
+output "token" {
+  value = provider::lxd::trust_token_decode(%q)
+}
+
+output "no_expiry" {
+  value = provider::lxd::trust_token_decode(%q)
+}
+	`, token, noExpiryToken)
+}
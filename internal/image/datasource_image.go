@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
@@ -106,14 +107,7 @@ func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	imageRemote := ""
-	identifier := state.Image.ValueString()
-
-	imageParts := strings.SplitN(identifier, ":", 2)
-	if len(imageParts) == 2 {
-		imageRemote = imageParts[0]
-		identifier = imageParts[1]
-	}
+	imageRemote, identifier := common.ParseImageRef(state.Image.ValueString())
 
 	imageType := state.Type.ValueString()
 	if imageType == "" {
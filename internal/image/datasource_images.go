@@ -0,0 +1,308 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type ImagesDataSourceModel struct {
+	AliasPrefix  types.String `tfsdk:"alias_prefix"`
+	Architecture types.String `tfsdk:"architecture"`
+	Latest       types.Bool   `tfsdk:"latest"`
+	Project      types.String `tfsdk:"project"`
+	Properties   types.Map    `tfsdk:"properties"`
+	Public       types.Bool   `tfsdk:"public"`
+	Remote       types.String `tfsdk:"remote"`
+	Type         types.String `tfsdk:"type"`
+
+	// Computed.
+	Images []ImagesItemModel `tfsdk:"images"`
+}
+
+type ImagesItemModel struct {
+	Aliases      types.Set    `tfsdk:"aliases"`
+	Architecture types.String `tfsdk:"architecture"`
+	CreatedAt    types.Int64  `tfsdk:"created_at"`
+	Fingerprint  types.String `tfsdk:"fingerprint"`
+	Properties   types.Map    `tfsdk:"properties"`
+	Public       types.Bool   `tfsdk:"public"`
+	Type         types.String `tfsdk:"type"`
+}
+
+type ImagesDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func NewImagesDataSource() datasource.DataSource {
+	return &ImagesDataSource{}
+}
+
+func (d *ImagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_images", req.ProviderTypeName)
+}
+
+func (d *ImagesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"remote": schema.StringAttribute{
+				Optional:    true,
+				Description: "Image server remote. If omitted, the provider's default remote is used.",
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("container", "virtual-machine"),
+				},
+			},
+
+			"architecture": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					architectureValidator{},
+				},
+			},
+
+			"properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Image properties that must match, for example os, release, variant, or serial.",
+			},
+
+			"public": schema.BoolAttribute{
+				Optional: true,
+			},
+
+			"alias_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix that at least one of the image aliases must start with.",
+			},
+
+			"latest": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Return only the most recently created matching image.",
+			},
+
+			// Computed.
+
+			"images": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of matching images, ordered from the newest to the oldest",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fingerprint": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"architecture": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"public": schema.BoolAttribute{
+							Computed: true,
+						},
+
+						"aliases": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"properties": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"created_at": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ImagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ImagesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, diags := common.ToConfigMap(ctx, state.Properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	server, err := d.provider.ImageServer(remote)
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewImageServerError(err))
+		return
+	}
+
+	// Set project if we are dealing with instance server.
+	instServer, ok := server.(lxd.InstanceServer)
+	if ok {
+		project := state.Project.ValueString()
+		if project == "" {
+			project = d.provider.Project(remote)
+		}
+
+		server = instServer.UseProject(project)
+	}
+
+	images, err := server.GetImages()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve images", err.Error())
+		return
+	}
+
+	// Simplestreams servers do not support server side filtering,
+	// therefore images are always filtered on the client.
+	filter := imagesFilter{
+		architecture: state.Architecture.ValueString(),
+		imageType:    state.Type.ValueString(),
+		aliasPrefix:  state.AliasPrefix.ValueString(),
+		properties:   properties,
+	}
+
+	if !state.Public.IsNull() {
+		public := state.Public.ValueBool()
+		filter.public = &public
+	}
+
+	images = slices.DeleteFunc(images, func(image api.Image) bool {
+		return !filter.match(image)
+	})
+
+	// Sort images from the newest to the oldest. Fingerprint is used as
+	// a tie-breaker to ensure the order is deterministic.
+	slices.SortFunc(images, func(a api.Image, b api.Image) int {
+		c := b.CreatedAt.Compare(a.CreatedAt)
+		if c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Fingerprint, b.Fingerprint)
+	})
+
+	if state.Latest.ValueBool() && len(images) > 1 {
+		images = images[:1]
+	}
+
+	state.Images = make([]ImagesItemModel, 0, len(images))
+	for _, image := range images {
+		aliases := make([]string, 0, len(image.Aliases))
+		for _, a := range image.Aliases {
+			aliases = append(aliases, a.Name)
+		}
+
+		aliasSet, diags := ToAliasSetType(ctx, aliases)
+		resp.Diagnostics.Append(diags...)
+
+		imageProperties, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(image.Properties), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		state.Images = append(state.Images, ImagesItemModel{
+			Aliases:      aliasSet,
+			Architecture: types.StringValue(image.Architecture),
+			CreatedAt:    types.Int64Value(image.CreatedAt.Unix()),
+			Fingerprint:  types.StringValue(image.Fingerprint),
+			Properties:   imageProperties,
+			Public:       types.BoolValue(image.Public),
+			Type:         types.StringValue(image.Type),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// imagesFilter contains the criteria an image must match. Empty values
+// match any image.
+type imagesFilter struct {
+	architecture string
+	imageType    string
+	aliasPrefix  string
+	public       *bool
+	properties   map[string]string
+}
+
+// match reports whether the image matches all the filter criteria.
+func (f imagesFilter) match(image api.Image) bool {
+	if f.architecture != "" && image.Architecture != f.architecture {
+		return false
+	}
+
+	// Images without a type are containers.
+	imageType := image.Type
+	if imageType == "" {
+		imageType = "container"
+	}
+
+	if f.imageType != "" && imageType != f.imageType {
+		return false
+	}
+
+	if f.public != nil && image.Public != *f.public {
+		return false
+	}
+
+	for k, v := range f.properties {
+		if image.Properties[k] != v {
+			return false
+		}
+	}
+
+	if f.aliasPrefix != "" {
+		return slices.ContainsFunc(image.Aliases, func(alias api.ImageAlias) bool {
+			return strings.HasPrefix(alias.Name, f.aliasPrefix)
+		})
+	}
+
+	return true
+}
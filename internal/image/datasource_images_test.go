@@ -0,0 +1,70 @@
+package image_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccImages_DS_latest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccImages_DS_latest(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_images.alpine", "images.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_images.alpine", "images.0.type", "container"),
+					resource.TestCheckResourceAttr("data.lxd_images.alpine", "images.0.architecture", "x86_64"),
+					resource.TestCheckResourceAttr("data.lxd_images.alpine", "images.0.properties.os", "Alpinelinux"),
+					resource.TestCheckResourceAttr("data.lxd_images.alpine", "images.0.properties.variant", "cloud"),
+					resource.TestCheckResourceAttrSet("data.lxd_images.alpine", "images.0.fingerprint"),
+					resource.TestCheckResourceAttrSet("data.lxd_images.alpine", "images.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImages_DS_noMatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccImages_DS_noMatch(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_images.none", "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImages_DS_latest() string {
+	return `
+data "lxd_images" "alpine" {
+  remote       = "images"
+  type         = "container"
+  architecture = "x86_64"
+  alias_prefix = "alpine/edge"
+  latest       = true
+
+  properties = {
+    os      = "Alpinelinux"
+    variant = "cloud"
+  }
+}
+`
+}
+
+func testAccImages_DS_noMatch() string {
+	return `
+data "lxd_images" "none" {
+  remote       = "images"
+  alias_prefix = "does-not-exist/"
+}
+`
+}
@@ -2,12 +2,18 @@ package image
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/utils"
@@ -36,15 +43,17 @@ import (
 type ImageModel struct {
 	SourceImage    types.Object `tfsdk:"source_image"`
 	SourceInstance types.Object `tfsdk:"source_instance"`
+	SourceFile     types.Object `tfsdk:"source_file"`
 	Aliases        types.Set    `tfsdk:"aliases"`
 	Project        types.String `tfsdk:"project"`
 	Remote         types.String `tfsdk:"remote"`
 
 	// Computed.
-	ResourceID    types.String `tfsdk:"resource_id"`
-	CreatedAt     types.Int64  `tfsdk:"created_at"`
-	Fingerprint   types.String `tfsdk:"fingerprint"`
-	CopiedAliases types.Set    `tfsdk:"copied_aliases"`
+	ResourceID    types.String   `tfsdk:"resource_id"`
+	CreatedAt     types.Int64    `tfsdk:"created_at"`
+	Fingerprint   types.String   `tfsdk:"fingerprint"`
+	CopiedAliases types.Set      `tfsdk:"copied_aliases"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SourceImageModel struct {
@@ -59,6 +68,16 @@ type SourceInstanceModel struct {
 	Snapshot types.String `tfsdk:"snapshot"`
 }
 
+// SourceFileModel represents the local files from which an image is
+// uploaded, along with the writable properties of the uploaded image.
+type SourceFileModel struct {
+	DataPath     types.String `tfsdk:"data_path"`
+	MetadataPath types.String `tfsdk:"metadata_path"`
+	Properties   types.Map    `tfsdk:"properties"`
+	Public       types.Bool   `tfsdk:"public"`
+	AutoUpdate   types.Bool   `tfsdk:"auto_update"`
+}
+
 // ImageResource represent LXD image resource.
 type ImageResource struct {
 	provider *provider_config.LxdProviderConfig
@@ -73,7 +92,7 @@ func (r ImageResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_image"
 }
 
-func (r ImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r ImageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"source_image": schema.SingleNestedAttribute{
@@ -107,7 +126,7 @@ func (r ImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImported(),
 				},
 			},
 
@@ -122,7 +141,42 @@ func (r ImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImported(),
+				},
+			},
+
+			"source_file": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"data_path": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"metadata_path": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"properties": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"public": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"auto_update": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfSourceFileRemoved(),
 				},
 			},
 
@@ -140,7 +194,6 @@ func (r ImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -183,6 +236,9 @@ func (r ImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -202,6 +258,55 @@ func (r *ImageResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.provider = provider
 }
 
+func (r *ImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var plan ImageModel
+
+		diags := req.Plan.Get(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// For images uploaded from local files, calculate the fingerprint
+		// in advance, so that changes of the files are detected.
+		if !plan.SourceFile.IsNull() && !plan.SourceFile.IsUnknown() {
+			fingerprint, diags := sourceFileFingerprint(ctx, plan.SourceFile)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			var stateFingerprint types.String
+			if !req.State.Raw.IsNull() {
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fingerprint"), &stateFingerprint)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+
+			if fingerprint != "" {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), fingerprint)...)
+
+				// Replace the image if the files have changed. Changed
+				// paths of files with the same content are updated in place.
+				if !stateFingerprint.IsNull() && stateFingerprint.ValueString() != fingerprint {
+					resp.RequiresReplace.Append(path.Root("fingerprint"))
+				}
+			} else if !stateFingerprint.IsNull() {
+				// The files are not available yet (e.g. they are produced
+				// in the same apply), so it cannot be determined whether
+				// they have changed. Replace the image to be safe, without
+				// carrying over the fingerprint of the old image.
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), types.StringUnknown())...)
+				resp.RequiresReplace.Append(path.Root("fingerprint"))
+			}
+		}
+	}
+
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r ImageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	if req.Config.Raw.IsNull() {
 		return
@@ -215,18 +320,25 @@ func (r ImageResource) ValidateConfig(ctx context.Context, req resource.Validate
 		return
 	}
 
-	if config.SourceImage.IsNull() && config.SourceInstance.IsNull() {
+	sources := 0
+	for _, source := range []types.Object{config.SourceImage, config.SourceInstance, config.SourceFile} {
+		if !source.IsNull() {
+			sources++
+		}
+	}
+
+	if sources == 0 {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"Either source_image or source_instance must be set.",
+			"One of source_image, source_instance, or source_file must be set.",
 		)
 		return
 	}
 
-	if !config.SourceImage.IsNull() && !config.SourceInstance.IsNull() {
+	if sources > 1 {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"Only source_image or source_instance can be set.",
+			"Only one of source_image, source_instance, or source_file can be set.",
 		)
 		return
 	}
@@ -241,12 +353,24 @@ func (r ImageResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	if !plan.SourceImage.IsNull() {
 		r.createImageFromSourceImage(ctx, resp, &plan)
 		return
 	} else if !plan.SourceInstance.IsNull() {
 		r.createImageFromSourceInstance(ctx, resp, &plan)
 		return
+	} else if !plan.SourceFile.IsNull() {
+		r.createImageFromSourceFile(ctx, resp, &plan)
+		return
 	}
 }
 
@@ -260,6 +384,15 @@ func (r ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -283,6 +416,15 @@ func (r ImageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -295,12 +437,60 @@ func (r ImageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	_, imageFingerprint := splitImageResourceID(plan.ResourceID.ValueString())
 
 	// Get info about the image.
-	image, _, err := server.GetImage(imageFingerprint)
+	image, etag, err := server.GetImage(imageFingerprint)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve image with fingerprint %q", imageFingerprint), err.Error())
 		return
 	}
 
+	// Apply changes of the uploaded image's writable properties.
+	if !plan.SourceFile.IsNull() && !state.SourceFile.IsNull() && !plan.SourceFile.Equal(state.SourceFile) {
+		var planSourceFile SourceFileModel
+		var stateSourceFile SourceFileModel
+
+		resp.Diagnostics.Append(plan.SourceFile.As(ctx, &planSourceFile, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(state.SourceFile.As(ctx, &stateSourceFile, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		oldProperties, diags := common.ToConfigMap(ctx, stateSourceFile.Properties)
+		resp.Diagnostics.Append(diags...)
+
+		newProperties, diags := common.ToConfigMap(ctx, planSourceFile.Properties)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Merge properties, so that the ones set by LXD are preserved.
+		imagePut := image.Writable()
+		if imagePut.Properties == nil {
+			imagePut.Properties = make(map[string]string, len(newProperties))
+		}
+
+		for k := range oldProperties {
+			_, ok := newProperties[k]
+			if !ok {
+				delete(imagePut.Properties, k)
+			}
+		}
+
+		for k, v := range newProperties {
+			imagePut.Properties[k] = v
+		}
+
+		imagePut.Public = planSourceFile.Public.ValueBool()
+		imagePut.AutoUpdate = planSourceFile.AutoUpdate.ValueBool()
+
+		err = server.UpdateImage(imageFingerprint, imagePut, etag)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update image with fingerprint %q", imageFingerprint), err.Error())
+			return
+		}
+	}
+
 	// Parse current (old) image aliases.
 	oldAliases := make([]string, len(image.Aliases))
 	for i, alias := range image.Aliases {
@@ -309,7 +499,7 @@ func (r ImageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Parse expected (new) image aliases.
 	copiedAliases := make([]string, 0, len(plan.CopiedAliases.Elements()))
-	diags := req.State.GetAttribute(ctx, path.Root("copied_aliases"), &copiedAliases)
+	diags = req.State.GetAttribute(ctx, path.Root("copied_aliases"), &copiedAliases)
 	resp.Diagnostics.Append(diags...)
 
 	newAliases, diags := ToAliasList(ctx, plan.Aliases)
@@ -362,6 +552,15 @@ func (r ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -383,6 +582,46 @@ func (r ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+func (r ImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "image",
+		RequiredFields: []string{"image"},
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	remote := fields["remote"]
+	project := fields["project"]
+	if project == "" {
+		project = r.provider.Project(remote)
+	}
+
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Image can be imported either by alias or by fingerprint.
+	image, err := resolveImage(server, fields["image"])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve image %q", fields["image"]), err.Error())
+		return
+	}
+
+	imageID := createImageResourceID(remote, image.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), imageID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	if remote != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote"), remote)...)
+	}
+}
+
 // TaintState marks the state with identity fields required to target the image.
 func (m ImageModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -434,6 +673,44 @@ func (r ImageResource) SyncState(ctx context.Context, tfState *tfsdk.State, serv
 		}
 	}
 
+	if !m.SourceFile.IsNull() {
+		var sourceFileModel SourceFileModel
+		respDiags = m.SourceFile.As(ctx, &sourceFileModel, basetypes.ObjectAsOptions{})
+		if respDiags.HasError() {
+			return respDiags
+		}
+
+		// Refresh the writable properties of the uploaded image. Only
+		// the configured properties are tracked, as LXD also sets
+		// properties from the image metadata.
+		if !sourceFileModel.Properties.IsNull() && !sourceFileModel.Properties.IsUnknown() {
+			configProperties, diags := common.ToConfigMap(ctx, sourceFileModel.Properties)
+			respDiags.Append(diags...)
+
+			properties := make(map[string]string, len(configProperties))
+			for k := range configProperties {
+				v, ok := image.Properties[k]
+				if ok {
+					properties[k] = v
+				}
+			}
+
+			sourceFileModel.Properties, diags = types.MapValueFrom(ctx, types.StringType, properties)
+			respDiags.Append(diags...)
+			if respDiags.HasError() {
+				return respDiags
+			}
+		}
+
+		sourceFileModel.Public = types.BoolValue(image.Public)
+		sourceFileModel.AutoUpdate = types.BoolValue(image.AutoUpdate)
+
+		m.SourceFile, respDiags = types.ObjectValueFrom(ctx, m.SourceFile.AttributeTypes(ctx), sourceFileModel)
+		if respDiags.HasError() {
+			return respDiags
+		}
+	}
+
 	configAliases, diags := ToAliasList(ctx, m.Aliases)
 	respDiags.Append(diags...)
 
@@ -482,14 +759,7 @@ func (r ImageResource) createImageFromSourceImage(ctx context.Context, resp *res
 
 	imageType := sourceImageModel.Type.ValueString()
 
-	var imageRemote string
-
-	image := sourceImageModel.Image.ValueString()
-	imageParts := strings.SplitN(image, ":", 2)
-	if len(imageParts) == 2 {
-		imageRemote = imageParts[0]
-		image = imageParts[1]
-	}
+	imageRemote, image := common.ParseImageRef(sourceImageModel.Image.ValueString())
 
 	imageServer, err := r.provider.ImageServer(imageRemote)
 	if err != nil {
@@ -539,20 +809,10 @@ func (r ImageResource) createImageFromSourceImage(ctx context.Context, resp *res
 		return
 	}
 
-	imageAliases := make([]api.ImageAlias, 0, len(aliases))
-	for _, alias := range aliases {
-		// Ensure image alias does not already exist.
-		aliasTarget, _, _ := server.GetImageAlias(alias)
-		if aliasTarget != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Image alias %q already exists", alias), "")
-			return
-		}
-
-		ia := api.ImageAlias{
-			Name: alias,
-		}
-
-		imageAliases = append(imageAliases, ia)
+	imageAliases, diags := newImageAliases(server, aliases)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	// Get data about remote image (also checks if image exists).
@@ -590,7 +850,7 @@ func (r ImageResource) createImageFromSourceImage(ctx context.Context, resp *res
 	}
 
 	// Wait for copy operation to finish.
-	err = opCopy.Wait()
+	err = common.WaitRemoteOperation(ctx, opCopy)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to copy image %q", image), err.Error())
 		return
@@ -654,20 +914,10 @@ func (r ImageResource) createImageFromSourceInstance(ctx context.Context, resp *
 		return
 	}
 
-	imageAliases := make([]api.ImageAlias, 0, len(aliases))
-	for _, alias := range aliases {
-		// Ensure image alias does not already exist.
-		aliasTarget, _, _ := server.GetImageAlias(alias)
-		if aliasTarget != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Image alias %q already exists", alias), "")
-			return
-		}
-
-		ia := api.ImageAlias{
-			Name: alias,
-		}
-
-		imageAliases = append(imageAliases, ia)
+	imageAliases, diags := newImageAliases(server, aliases)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	var source *api.ImagesPostSource
@@ -729,6 +979,184 @@ func (r ImageResource) createImageFromSourceInstance(ctx context.Context, resp *
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r ImageResource) createImageFromSourceFile(ctx context.Context, resp *resource.CreateResponse, plan *ImageModel) {
+	var sourceFileModel SourceFileModel
+
+	diags := plan.SourceFile.As(ctx, &sourceFileModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	aliases, diags := ToAliasList(ctx, plan.Aliases)
+	resp.Diagnostics.Append(diags...)
+
+	properties, diags := common.ToConfigMap(ctx, sourceFileModel.Properties)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imageAliases, diags := newImageAliases(server, aliases)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	dataPath := sourceFileModel.DataPath.ValueString()
+	dataFile, err := os.Open(dataPath)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to open image file %q", dataPath), err.Error())
+		return
+	}
+
+	defer dataFile.Close()
+
+	var args lxd.ImageCreateArgs
+
+	metadataPath := sourceFileModel.MetadataPath.ValueString()
+	if metadataPath == "" {
+		// Unified image, where metadata and rootfs are in a single file.
+		args.MetaFile = dataFile
+		args.MetaName = filepath.Base(dataPath)
+	} else {
+		// Split image with a separate metadata file.
+		metadataFile, err := os.Open(metadataPath)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to open image metadata file %q", metadataPath), err.Error())
+			return
+		}
+
+		defer metadataFile.Close()
+
+		args.MetaFile = metadataFile
+		args.MetaName = filepath.Base(metadataPath)
+		args.RootfsFile = dataFile
+		args.RootfsName = filepath.Base(dataPath)
+	}
+
+	imageReq := api.ImagesPost{
+		Aliases: imageAliases,
+		ImagePut: api.ImagePut{
+			Public:     sourceFileModel.Public.ValueBool(),
+			AutoUpdate: sourceFileModel.AutoUpdate.ValueBool(),
+			Properties: properties,
+		},
+	}
+
+	// Upload image.
+	op, err := server.CreateImage(imageReq, &args)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to upload image from file %q", dataPath), err.Error())
+		return
+	}
+
+	// Wait for create operation to finish.
+	err = op.WaitContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to upload image from file %q", dataPath), err.Error())
+		return
+	}
+
+	// Extract fingerprint from operation response.
+	opResp := op.Get()
+	imageFingerprint, ok := opResp.Metadata["fingerprint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to determine fingerprint of the uploaded image", "")
+		return
+	}
+
+	imageID := createImageResourceID(remote, imageFingerprint)
+
+	plan.Fingerprint = types.StringValue(imageFingerprint)
+	plan.ResourceID = types.StringValue(imageID)
+	plan.CopiedAliases = types.SetValueMust(types.StringType, []attr.Value{})
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, *plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+// newImageAliases converts aliases into image aliases of a new image. An error
+// is returned if any of the aliases already exists.
+func newImageAliases(server lxd.InstanceServer, aliases []string) ([]api.ImageAlias, diag.Diagnostics) {
+	imageAliases := make([]api.ImageAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		// Ensure image alias does not already exist.
+		aliasTarget, _, _ := server.GetImageAlias(alias)
+		if aliasTarget != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic(fmt.Sprintf("Image alias %q already exists", alias), "")}
+		}
+
+		ia := api.ImageAlias{
+			Name: alias,
+		}
+
+		imageAliases = append(imageAliases, ia)
+	}
+
+	return imageAliases, nil
+}
+
+// sourceFileFingerprint calculates the fingerprint of an image from its local
+// files the same way LXD does. For split images, the metadata file is hashed
+// first, followed by the rootfs file. An empty fingerprint is returned if the
+// file paths are not known yet or the files do not exist yet.
+func sourceFileFingerprint(ctx context.Context, sourceFile types.Object) (string, diag.Diagnostics) {
+	var sourceFileModel SourceFileModel
+
+	diags := sourceFile.As(ctx, &sourceFileModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if sourceFileModel.DataPath.IsUnknown() || sourceFileModel.MetadataPath.IsUnknown() {
+		return "", nil
+	}
+
+	paths := []string{sourceFileModel.DataPath.ValueString()}
+	if !sourceFileModel.MetadataPath.IsNull() {
+		paths = []string{sourceFileModel.MetadataPath.ValueString(), sourceFileModel.DataPath.ValueString()}
+	}
+
+	hash := sha256.New()
+	for _, p := range paths {
+		file, err := os.Open(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return "", diags
+			}
+
+			diags.AddError(fmt.Sprintf("Failed to open image file %q", p), err.Error())
+			return "", diags
+		}
+
+		_, err = io.Copy(hash, file)
+		_ = file.Close()
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to calculate fingerprint of image file %q", p), err.Error())
+			return "", diags
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), diags
+}
+
 // ToAliasList converts aliases of type types.Set into a slice of strings.
 func ToAliasList(ctx context.Context, aliasSet types.Set) ([]string, diag.Diagnostics) {
 	if aliasSet.IsNull() || aliasSet.IsUnknown() {
@@ -750,6 +1178,48 @@ func ToAliasSetType(ctx context.Context, aliases []string) (types.Set, diag.Diag
 	return types.SetValueFrom(ctx, types.StringType, aliases)
 }
 
+// requiresReplaceUnlessImported returns a plan modifier that requires the
+// image to be replaced when its source changes. Imported images have no
+// known source, so setting one does not trigger a replacement.
+func requiresReplaceUnlessImported() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			var sourceImage types.Object
+			var sourceInstance types.Object
+			var sourceFile types.Object
+
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source_image"), &sourceImage)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source_instance"), &sourceInstance)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source_file"), &sourceFile)...)
+
+			resp.RequiresReplace = !sourceImage.IsNull() || !sourceInstance.IsNull() || !sourceFile.IsNull()
+		},
+		"Image is replaced when its source changes, unless the image was imported.",
+		"Image is replaced when its source changes, unless the image was imported.",
+	)
+}
+
+// requiresReplaceIfSourceFileRemoved returns a plan modifier that requires
+// the image to be replaced when its source file is removed or not known yet.
+// Changes of the files themselves are detected by comparing fingerprints in
+// ModifyPlan, so that moved files with the same content and other source file
+// attributes, such as properties or the public flag, are updated in place.
+// Imported images have no known source, so setting one does not trigger a
+// replacement.
+func requiresReplaceIfSourceFileRemoved() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.RequiresReplace = req.PlanValue.IsNull() || req.PlanValue.IsUnknown()
+		},
+		"Image is replaced when its source file is removed, unless the image was imported.",
+		"Image is replaced when its source file is removed, unless the image was imported.",
+	)
+}
+
 // createImageResourceID creates new image ID by concatenating remote and
 // image fingerprint using colon.
 func createImageResourceID(remote string, fingerprint string) string {
@@ -0,0 +1,463 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// ImageExportModel represents an image exported from LXD into local files.
+type ImageExportModel struct {
+	Image        types.String `tfsdk:"image"`
+	DataPath     types.String `tfsdk:"data_path"`
+	MetadataPath types.String `tfsdk:"metadata_path"`
+	Project      types.String `tfsdk:"project"`
+	Remote       types.String `tfsdk:"remote"`
+
+	// Computed.
+	Fingerprint      types.String `tfsdk:"fingerprint"`
+	DataChecksum     types.String `tfsdk:"data_checksum"`
+	MetadataChecksum types.String `tfsdk:"metadata_checksum"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ImageExportResource represent LXD image export resource.
+type ImageExportResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewImageExportResource returns a new image export resource.
+func NewImageExportResource() resource.Resource {
+	return &ImageExportResource{}
+}
+
+func (r ImageExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_export"
+}
+
+func (r ImageExportResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"image": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"data_path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"metadata_path": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed.
+
+			"fingerprint": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"data_checksum": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"metadata_checksum": schema.StringAttribute{
+				Computed: true,
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *ImageExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *ImageExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan ImageExportModel
+	var state ImageExportModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the image reference has changed, the image may not exist yet.
+	// In such case, the fingerprint is resolved during the update.
+	if !plan.Image.Equal(state.Image) || plan.Project.IsUnknown() {
+		return
+	}
+
+	server, err := r.provider.InstanceServer(plan.Remote.ValueString(), plan.Project.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Resolve the image fingerprint to determine whether the image
+	// needs to be exported again. The alias may now point to a
+	// different image.
+	fingerprint := state.Fingerprint.ValueString()
+	image, err := resolveImage(server, plan.Image.ValueString())
+	if err == nil {
+		fingerprint = image.Fingerprint
+	} else if !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve image %q", plan.Image.ValueString()), err.Error())
+		return
+	}
+
+	if fingerprint != state.Fingerprint.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), fingerprint)...)
+		resp.RequiresReplace.Append(path.Root("fingerprint"))
+		return
+	}
+
+	// Exported files are kept as long as the fingerprint does not change.
+	// This also applies if the image no longer exists on the server.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), state.Fingerprint)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("data_checksum"), state.DataChecksum)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("metadata_checksum"), state.MetadataChecksum)...)
+}
+
+func (r ImageExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ImageExportModel
+
+	// Fetch resource model from Terraform plan.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	diag := plan.export(ctx, server)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	// Update Terraform state.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ImageExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ImageExportModel
+
+	// Fetch resource model from Terraform state.
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Export the image again if any of the exported files was removed.
+	for _, p := range []types.String{state.DataPath, state.MetadataPath} {
+		if p.IsNull() {
+			continue
+		}
+
+		_, err := os.Stat(p.ValueString())
+		if err != nil {
+			if os.IsNotExist(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to check exported image file %q", p.ValueString()), err.Error())
+			return
+		}
+	}
+}
+
+func (r ImageExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ImageExportModel
+	var state ImageExportModel
+
+	// Fetch resource model from Terraform plan.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	image, err := resolveImage(server, plan.Image.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve image %q", plan.Image.ValueString()), err.Error())
+		return
+	}
+
+	// Image reference has changed. Export the image again
+	// only if it resolves to a different fingerprint.
+	plan.Fingerprint = state.Fingerprint
+	plan.DataChecksum = state.DataChecksum
+	plan.MetadataChecksum = state.MetadataChecksum
+	if image.Fingerprint != state.Fingerprint.ValueString() {
+		diag := plan.export(ctx, server)
+		if diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	// Update Terraform state.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ImageExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Exported files are intentionally kept on disk.
+}
+
+// export writes the referenced image into the files on the configured paths
+// and updates the model with the image fingerprint and checksums of the
+// exported files.
+func (m *ImageExportModel) export(ctx context.Context, server lxd.InstanceServer) diag.Diagnostic {
+	image, err := resolveImage(server, m.Image.ValueString())
+	if err != nil {
+		return diag.NewErrorDiagnostic(fmt.Sprintf("Failed to retrieve image %q", m.Image.ValueString()), err.Error())
+	}
+
+	dataPath := m.DataPath.ValueString()
+	metadataPath := m.MetadataPath.ValueString()
+
+	dataChecksum, metadataChecksum, err := exportImage(ctx, server, image.Fingerprint, dataPath, metadataPath)
+	if err != nil {
+		return diag.NewErrorDiagnostic(fmt.Sprintf("Failed to export image %q to %q", m.Image.ValueString(), dataPath), err.Error())
+	}
+
+	m.Fingerprint = types.StringValue(image.Fingerprint)
+	m.DataChecksum = types.StringValue(dataChecksum)
+	m.MetadataChecksum = types.StringNull()
+	if metadataChecksum != "" {
+		m.MetadataChecksum = types.StringValue(metadataChecksum)
+	}
+
+	return nil
+}
+
+// resolveImage retrieves the image referenced either by alias or by
+// fingerprint, which may also be a fingerprint prefix.
+func resolveImage(server lxd.InstanceServer, identifier string) (*api.Image, error) {
+	fingerprint := identifier
+	alias, _, err := server.GetImageAlias(identifier)
+	if err == nil {
+		fingerprint = alias.Target
+	} else if !errors.IsNotFoundError(err) {
+		return nil, fmt.Errorf("Failed to get image alias %q: %w", identifier, err)
+	}
+
+	image, _, err := server.GetImage(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// exportImage downloads the image with the given fingerprint into local files
+// and returns SHA-256 checksums of the written files. Unified images are
+// written into a single file on the data path, while split images require
+// a separate metadata path. The files are removed if the export fails or
+// the context is cancelled.
+func exportImage(ctx context.Context, server lxd.InstanceServer, fingerprint string, dataPath string, metadataPath string) (dataChecksum string, metadataChecksum string, err error) {
+	dataFile, err := os.Create(dataPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Metadata file of a unified image contains the whole image. If the
+	// metadata path is not set, the image is expected to be unified, so
+	// the rootfs file is written into a temporary file which must remain
+	// empty.
+	var metaFile *os.File
+	var rootfsFile *os.File
+	if metadataPath != "" {
+		metaFile, err = os.Create(metadataPath)
+		rootfsFile = dataFile
+	} else {
+		metaFile = dataFile
+		rootfsFile, err = os.CreateTemp("", "lxd-image-rootfs-")
+	}
+
+	defer func() {
+		for _, f := range []*os.File{dataFile, metaFile, rootfsFile} {
+			if f != nil {
+				_ = f.Close()
+			}
+		}
+
+		if metadataPath == "" && rootfsFile != nil {
+			_ = os.Remove(rootfsFile.Name())
+		}
+
+		if err != nil {
+			_ = os.Remove(dataPath)
+			if metadataPath != "" {
+				_ = os.Remove(metadataPath)
+			}
+		}
+	}()
+
+	if err != nil {
+		return "", "", err
+	}
+
+	imageReq := lxd.ImageFileRequest{
+		MetaFile:   common.NewContextWriteSeeker(ctx, metaFile),
+		RootfsFile: common.NewContextWriteSeeker(ctx, rootfsFile),
+	}
+
+	imageResp, err := server.GetImageFile(fingerprint, imageReq)
+	if err != nil {
+		return "", "", err
+	}
+
+	isSplit := imageResp.RootfsSize > 0
+	if isSplit && metadataPath == "" {
+		return "", "", fmt.Errorf("Image %q is a split image, therefore metadata_path must be set", fingerprint)
+	}
+
+	if !isSplit && metadataPath != "" {
+		return "", "", fmt.Errorf("Image %q is a unified image, therefore metadata_path must not be set", fingerprint)
+	}
+
+	dataChecksum, err = fileChecksum(dataFile)
+	if err != nil {
+		return "", "", err
+	}
+
+	if isSplit {
+		metadataChecksum, err = fileChecksum(metaFile)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return dataChecksum, metadataChecksum, nil
+}
+
+// fileChecksum returns the SHA-256 checksum of the content of the given file.
+func fileChecksum(file *os.File) (string, error) {
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,97 @@
+package image_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccImageExport_split(t *testing.T) {
+	dir := t.TempDir()
+	dataPath := filepath.Join(dir, "rootfs.squashfs")
+	metadataPath := filepath.Join(dir, "lxd.tar.xz")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccImageExport_split(dataPath, metadataPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lxd_image_export.export1", "fingerprint", "lxd_image.img1", "fingerprint"),
+					resource.TestCheckResourceAttrWith("lxd_image_export.export1", "data_checksum", testImageFingerprint(dataPath)),
+					resource.TestCheckResourceAttrWith("lxd_image_export.export1", "metadata_checksum", testImageFingerprint(metadataPath)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageExport_unified(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "image.tar")
+	exportPath := filepath.Join(dir, "export.tar")
+	alias := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeTestImage(t, imagePath, "first") },
+				Config:    acctest.Provider() + testAccImageExport_unified(imagePath, exportPath, alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lxd_image_export.export1", "fingerprint", "lxd_image.img1", "fingerprint"),
+					resource.TestCheckResourceAttrWith("lxd_image_export.export1", "data_checksum", testImageFingerprint(imagePath)),
+					resource.TestCheckNoResourceAttr("lxd_image_export.export1", "metadata_checksum"),
+				),
+			},
+			{
+				// Changing the image behind the alias exports the image again.
+				PreConfig: func() { writeTestImage(t, imagePath, "second") },
+				Config:    acctest.Provider() + testAccImageExport_unified(imagePath, exportPath, alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lxd_image_export.export1", "fingerprint", "lxd_image.img1", "fingerprint"),
+					resource.TestCheckResourceAttrWith("lxd_image_export.export1", "data_checksum", testImageFingerprint(exportPath)),
+					resource.TestCheckResourceAttrWith("lxd_image_export.export1", "data_checksum", testImageFingerprint(imagePath)),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageExport_split(dataPath string, metadataPath string) string {
+	return fmt.Sprintf(`
+resource "lxd_image" "img1" {
+  source_image = {
+    image = "%s"
+  }
+}
+
+resource "lxd_image_export" "export1" {
+  image         = lxd_image.img1.fingerprint
+  data_path     = "%s"
+  metadata_path = "%s"
+}
+	`, acctest.TestCachedImage, dataPath, metadataPath)
+}
+
+func testAccImageExport_unified(imagePath string, exportPath string, alias string) string {
+	return fmt.Sprintf(`
+resource "lxd_image" "img1" {
+  aliases = ["%s"]
+
+  source_file = {
+    data_path = "%s"
+  }
+}
+
+resource "lxd_image_export" "export1" {
+  image     = lxd_image.img1.fingerprint
+  data_path = "%s"
+}
+	`, alias, imagePath, exportPath)
+}
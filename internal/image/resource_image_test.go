@@ -1,12 +1,19 @@
 package image_test
 
 import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
@@ -356,6 +363,98 @@ func TestAccImage_sourceInstanceWithSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccImage_importAlias(t *testing.T) {
+	alias := acctest.GenerateName(2, "-")
+	resourceName := "lxd_image.img2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccImage_aliases(alias),
+			},
+			{
+				Config:                               acctest.Provider() + testAccImage_aliases(alias),
+				ResourceName:                         resourceName,
+				ImportStateId:                        alias,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+				ImportStateVerifyIgnore:              []string{"source_image", "copied_aliases"},
+			},
+		},
+	})
+}
+
+func TestAccImage_sourceFile(t *testing.T) {
+	alias := acctest.GenerateName(2, "-")
+	imagePath := filepath.Join(t.TempDir(), "image.tar")
+	movedImagePath := filepath.Join(t.TempDir(), "image.tar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeTestImage(t, imagePath, "first") },
+				Config:    acctest.Provider() + testAccImage_sourceFile(imagePath, alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.data_path", imagePath),
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.properties.os", "test"),
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.public", "false"),
+					resource.TestCheckResourceAttr("lxd_image.img1", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("lxd_image.img1", "aliases.0", alias),
+					resource.TestCheckResourceAttrWith("lxd_image.img1", "fingerprint", testImageFingerprint(imagePath)),
+				),
+			},
+			{
+				// Changing the file content replaces the image.
+				PreConfig: func() { writeTestImage(t, imagePath, "second") },
+				Config:    acctest.Provider() + testAccImage_sourceFile(imagePath, alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_image.img1", "aliases.0", alias),
+					resource.TestCheckResourceAttrWith("lxd_image.img1", "fingerprint", testImageFingerprint(imagePath)),
+				),
+			},
+			{
+				// Changing the image properties updates the image in place.
+				Config: acctest.Provider() + testAccImage_sourceFilePublic(imagePath, alias),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_image.img1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.properties.os", "updated"),
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.public", "true"),
+					resource.TestCheckResourceAttrWith("lxd_image.img1", "fingerprint", testImageFingerprint(imagePath)),
+				),
+			},
+			{
+				// Moving the file without changing its content updates
+				// the image in place.
+				PreConfig: func() {
+					err := os.Rename(imagePath, movedImagePath)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: acctest.Provider() + testAccImage_sourceFilePublic(movedImagePath, alias),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_image.img1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_image.img1", "source_file.data_path", movedImagePath),
+					resource.TestCheckResourceAttrWith("lxd_image.img1", "fingerprint", testImageFingerprint(movedImagePath)),
+				),
+			},
+		},
+	})
+}
+
 func testAccImage_basic() string {
 	return fmt.Sprintf(`
 resource "lxd_image" "img1" {
@@ -611,3 +710,86 @@ resource "lxd_image" "img1" {
 }
 	`, projectName, instanceName, acctest.TestImage)
 }
+
+func testAccImage_sourceFile(imagePath string, alias string) string {
+	return fmt.Sprintf(`
+resource "lxd_image" "img1" {
+  aliases = ["%s"]
+
+  source_file = {
+    data_path = "%s"
+    properties = {
+      os = "test"
+    }
+  }
+}
+	`, alias, imagePath)
+}
+
+func testAccImage_sourceFilePublic(imagePath string, alias string) string {
+	return fmt.Sprintf(`
+resource "lxd_image" "img1" {
+  aliases = ["%s"]
+
+  source_file = {
+    data_path = "%s"
+    public    = true
+    properties = {
+      os = "updated"
+    }
+  }
+}
+	`, alias, imagePath)
+}
+
+// writeTestImage writes a minimal unified container image to the given path.
+// The description is included in the image metadata to alter the fingerprint.
+func writeTestImage(t *testing.T, path string, description string) {
+	t.Helper()
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	metadata := fmt.Sprintf("architecture: x86_64\ncreation_date: %d\nproperties:\n  description: %s\n", time.Now().Unix(), description)
+
+	tw := tar.NewWriter(file)
+	err = tw.WriteHeader(&tar.Header{Name: "metadata.yaml", Mode: 0644, Size: int64(len(metadata))})
+	if err == nil {
+		_, err = tw.Write([]byte(metadata))
+	}
+
+	if err == nil {
+		err = tw.WriteHeader(&tar.Header{Name: "rootfs/", Mode: 0755, Typeflag: tar.TypeDir})
+	}
+
+	if err == nil {
+		err = tw.Close()
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testImageFingerprint returns a check function that compares the value
+// against the SHA256 hash of the file on the given path.
+func testImageFingerprint(path string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256(content)
+		fingerprint := hex.EncodeToString(hash[:])
+		if value != fingerprint {
+			return fmt.Errorf("Expected fingerprint %q, got %q", fingerprint, value)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,226 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// InstanceExecActionModel represents the configuration of a command executed
+// in an instance.
+type InstanceExecActionModel struct {
+	Instance     types.String `tfsdk:"instance"`
+	Command      types.List   `tfsdk:"command"`
+	Environment  types.Map    `tfsdk:"environment"`
+	WorkingDir   types.String `tfsdk:"working_dir"`
+	UserID       types.Int64  `tfsdk:"uid"`
+	GroupID      types.Int64  `tfsdk:"gid"`
+	RecordOutput types.Bool   `tfsdk:"record_output"`
+	FailOnError  types.Bool   `tfsdk:"fail_on_error"`
+	Project      types.String `tfsdk:"project"`
+	Remote       types.String `tfsdk:"remote"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceExecAction represent LXD instance exec action.
+type InstanceExecAction struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewInstanceExecAction returns a new instance exec action.
+func NewInstanceExecAction() action.Action {
+	return &InstanceExecAction{}
+}
+
+func (a InstanceExecAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_instance_exec", req.ProviderTypeName)
+}
+
+func (a InstanceExecAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a command within a running instance.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"command": schema.ListAttribute{
+				Required:    true,
+				Description: "Command to run within the instance",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+
+			"environment": schema.MapAttribute{
+				Optional:    true,
+				Description: "Map of additional environment variables",
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"working_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "The directory in which the command should run",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"uid": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The user ID for running command",
+			},
+
+			"gid": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The group ID for running command",
+			},
+
+			"record_output": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to report command's output (stdout and stderr) as progress messages. Defaults to false.",
+			},
+
+			"fail_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to fail if the command exits with a non-zero exit code. Defaults to true.",
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Custom timeouts.
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (a *InstanceExecAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	a.provider = provider
+}
+
+func (a InstanceExecAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config InstanceExecActionModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set invocation timeout.
+	timeout, diags := config.Timeouts.Invoke(ctx, a.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := config.Remote.ValueString()
+	project := config.Project.ValueString()
+	server, err := a.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := config.Instance.ValueString()
+
+	environment := config.Environment
+	if environment.IsNull() {
+		environment = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	// Fail on error unless explicitly disabled.
+	failOnError := config.FailOnError.IsNull() || config.FailOnError.ValueBool()
+
+	exec := common.ExecModel{
+		Command:      config.Command,
+		Environment:  environment,
+		WorkingDir:   config.WorkingDir,
+		RecordOutput: config.RecordOutput,
+		FailOnError:  types.BoolValue(failOnError),
+		UserID:       config.UserID,
+		GroupID:      config.GroupID,
+		RunCount:     types.Int64Value(0),
+	}
+
+	var command []string
+	resp.Diagnostics.Append(config.Command.ElementsAs(ctx, &command, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running command %q on instance %q", strings.Join(command, " "), instanceName),
+	})
+
+	resp.Diagnostics.Append(exec.Execute(ctx, server, instanceName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if exec.RecordOutput.ValueBool() {
+		stdout := exec.Output.ValueString()
+		if stdout != "" {
+			resp.SendProgress(action.InvokeProgressEvent{Message: stdout})
+		}
+
+		stderr := exec.Error.ValueString()
+		if stderr != "" {
+			resp.SendProgress(action.InvokeProgressEvent{Message: stderr})
+		}
+	}
+
+	exitCode := exec.ExitCode.ValueInt64()
+	if exitCode != 0 {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Command failed on instance %q", instanceName),
+			fmt.Sprintf("Command %q exited with a non-zero exit code (%d)", strings.Join(command, " "), exitCode),
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package instance_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccInstanceExecAction_basic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceExecAction_basic(instanceName, "/root/marker"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+				),
+			},
+			{
+				// Ensure the command failure is reported.
+				Config:      acctest.Provider() + testAccInstanceExecAction_basic(instanceName, "/root/missing"),
+				ExpectError: regexp.MustCompile(`Failed to execute command on instance`),
+			},
+		},
+	})
+}
+
+func testAccInstanceExecAction_basic(instanceName string, checkPath string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+}
+
+action "lxd_instance_exec" "write" {
+  config {
+    instance    = lxd_instance.instance1.name
+    command     = ["/bin/sh", "-c", "touch $FILE"]
+    environment = {
+      FILE = "/root/marker"
+    }
+  }
+}
+
+action "lxd_instance_exec" "check" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["test", "-f", %[3]q]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[3]q
+
+  depends_on = [lxd_instance.instance1]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.lxd_instance_exec.write, action.lxd_instance_exec.check]
+    }
+  }
+}
+	`, instanceName, acctest.TestImage, checkPath)
+}
@@ -0,0 +1,153 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// InstanceRestartActionModel represents the configuration of an instance restart action.
+type InstanceRestartActionModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Force    types.Bool   `tfsdk:"force"`
+	Project  types.String `tfsdk:"project"`
+	Remote   types.String `tfsdk:"remote"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceRestartAction represent LXD instance restart action.
+type InstanceRestartAction struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewInstanceRestartAction returns a new instance restart action.
+func NewInstanceRestartAction() action.Action {
+	return &InstanceRestartAction{}
+}
+
+func (a InstanceRestartAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_instance_restart", req.ProviderTypeName)
+}
+
+func (a InstanceRestartAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts an instance. A stopped instance is started.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to force the instance to stop. Defaults to false.",
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Custom timeouts.
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (a *InstanceRestartAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	a.provider = provider
+}
+
+func (a InstanceRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config InstanceRestartActionModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set invocation timeout.
+	timeout, diags := config.Timeouts.Invoke(ctx, a.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := config.Remote.ValueString()
+	project := config.Project.ValueString()
+	server, err := a.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := config.Instance.ValueString()
+
+	instance, _, err := server.GetInstance(instanceName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve instance %q", instanceName), err.Error())
+		return
+	}
+
+	// Ephemeral instances are removed once stopped, therefore the
+	// instance would not be started again.
+	if instance.Ephemeral {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to restart instance %q", instanceName),
+			"Ephemeral instances cannot be restarted, because they are removed once stopped.",
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Stopping instance %q", instanceName),
+	})
+
+	_, diag := stopInstance(ctx, server, a.provider.InstanceEvents(remote), instanceName, config.Force.ValueBool())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting instance %q", instanceName),
+	})
+
+	diag = startInstance(ctx, server, a.provider.InstanceEvents(remote), instanceName)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
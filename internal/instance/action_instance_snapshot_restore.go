@@ -0,0 +1,150 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// InstanceSnapshotRestoreActionModel represents the configuration of an
+// instance snapshot restore action.
+type InstanceSnapshotRestoreActionModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Snapshot types.String `tfsdk:"snapshot"`
+	Stateful types.Bool   `tfsdk:"stateful"`
+	Project  types.String `tfsdk:"project"`
+	Remote   types.String `tfsdk:"remote"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceSnapshotRestoreAction represent LXD instance snapshot restore action.
+type InstanceSnapshotRestoreAction struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewInstanceSnapshotRestoreAction returns a new instance snapshot restore action.
+func NewInstanceSnapshotRestoreAction() action.Action {
+	return &InstanceSnapshotRestoreAction{}
+}
+
+func (a InstanceSnapshotRestoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_instance_snapshot_restore", req.ProviderTypeName)
+}
+
+func (a InstanceSnapshotRestoreAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores an instance from one of its snapshots.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"snapshot": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the snapshot to restore.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"stateful": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to restore the runtime state of a stateful snapshot. Defaults to false.",
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Custom timeouts.
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (a *InstanceSnapshotRestoreAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	a.provider = provider
+}
+
+func (a InstanceSnapshotRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config InstanceSnapshotRestoreActionModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set invocation timeout.
+	timeout, diags := config.Timeouts.Invoke(ctx, a.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := config.Remote.ValueString()
+	project := config.Project.ValueString()
+	server, err := a.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := config.Instance.ValueString()
+	snapshotName := config.Snapshot.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restoring instance %q from snapshot %q", instanceName, snapshotName),
+	})
+
+	// Only the restore is performed when the restore field is set,
+	// the rest of the instance configuration is left unchanged.
+	instanceReq := api.InstancePut{
+		Restore:  snapshotName,
+		Stateful: config.Stateful.ValueBool(),
+	}
+
+	op, err := server.UpdateInstance(instanceName, instanceReq, "")
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to restore instance %q from snapshot %q", instanceName, snapshotName), err.Error())
+	}
+}
@@ -0,0 +1,84 @@
+package instance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccInstanceSnapshotRestoreAction_basic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceSnapshotRestoreAction_basic(instanceName, snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance_snapshot.snapshot1", "name", snapshotName),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceSnapshotRestoreAction_basic(instanceName string, snapshotName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+}
+
+resource "lxd_instance_snapshot" "snapshot1" {
+  name     = %[3]q
+  instance = lxd_instance.instance1.name
+}
+
+action "lxd_instance_exec" "write" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["touch", "/root/marker"]
+  }
+}
+
+action "lxd_instance_snapshot_restore" "restore" {
+  config {
+    instance = lxd_instance.instance1.name
+    snapshot = lxd_instance_snapshot.snapshot1.name
+  }
+}
+
+# The marker was written after the snapshot was taken.
+action "lxd_instance_exec" "check" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["/bin/sh", "-c", "test ! -f /root/marker"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  depends_on = [lxd_instance_snapshot.snapshot1]
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [
+        action.lxd_instance_exec.write,
+        action.lxd_instance_snapshot_restore.restore,
+        action.lxd_instance_exec.check,
+      ]
+    }
+  }
+}
+	`, instanceName, acctest.TestImage, snapshotName)
+}
@@ -0,0 +1,142 @@
+package instance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccInstanceStartAction_basic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceStartAction_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstanceStartAction_alreadyRunning(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceStartAction_alreadyRunning(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceStartAction_basic(instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+}
+
+action "lxd_instance_stop" "stop" {
+  config {
+    instance = lxd_instance.instance1.name
+    force    = true
+  }
+}
+
+action "lxd_instance_start" "start" {
+  config {
+    instance = lxd_instance.instance1.name
+  }
+}
+
+# Commands can only be executed in a running instance.
+action "lxd_instance_exec" "check" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["true"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  depends_on = [lxd_instance.instance1]
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [
+        action.lxd_instance_stop.stop,
+        action.lxd_instance_start.start,
+        action.lxd_instance_exec.check,
+      ]
+    }
+  }
+}
+	`, instanceName, acctest.TestImage)
+}
+
+func testAccInstanceStartAction_alreadyRunning(instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+}
+
+action "lxd_instance_start" "start" {
+  config {
+    instance = lxd_instance.instance1.name
+  }
+}
+
+# Write a marker to tmpfs, which would be cleared if the instance was restarted.
+action "lxd_instance_exec" "write" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["touch", "/run/marker"]
+  }
+}
+
+action "lxd_instance_exec" "check" {
+  config {
+    instance = lxd_instance.instance1.name
+    command  = ["test", "-f", "/run/marker"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  depends_on = [lxd_instance.instance1]
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [
+        action.lxd_instance_exec.write,
+        action.lxd_instance_start.start,
+        action.lxd_instance_exec.check,
+      ]
+    }
+  }
+}
+	`, instanceName, acctest.TestImage)
+}
@@ -0,0 +1,129 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// InstanceStopActionModel represents the configuration of an instance stop action.
+type InstanceStopActionModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Force    types.Bool   `tfsdk:"force"`
+	Project  types.String `tfsdk:"project"`
+	Remote   types.String `tfsdk:"remote"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceStopAction represent LXD instance stop action.
+type InstanceStopAction struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewInstanceStopAction returns a new instance stop action.
+func NewInstanceStopAction() action.Action {
+	return &InstanceStopAction{}
+}
+
+func (a InstanceStopAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_instance_stop", req.ProviderTypeName)
+}
+
+func (a InstanceStopAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Stops an instance. Nothing is done if the instance is already stopped.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to force the instance to stop. Defaults to false.",
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Custom timeouts.
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (a *InstanceStopAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	a.provider = provider
+}
+
+func (a InstanceStopAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config InstanceStopActionModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set invocation timeout.
+	timeout, diags := config.Timeouts.Invoke(ctx, a.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := config.Remote.ValueString()
+	project := config.Project.ValueString()
+	server, err := a.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := config.Instance.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Stopping instance %q", instanceName),
+	})
+
+	// Ephemeral instances are removed once stopped, in which case
+	// the stop is still considered successful.
+	found, diag := stopInstance(ctx, server, a.provider.InstanceEvents(remote), instanceName, config.Force.ValueBool())
+	if diag != nil && found {
+		resp.Diagnostics.Append(diag)
+	}
+}
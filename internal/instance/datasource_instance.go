@@ -213,54 +213,10 @@ func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Set null values for IPv4, IPv6, and MAC addresses to ensure
-	// the computed value is always set.
-	state.IPv4 = types.StringNull()
-	state.IPv6 = types.StringNull()
-	state.MAC = types.StringNull()
-
-	accIface, ok := instance.ExpandedConfig["user.access_interface"]
-	if ok {
-		// If there is an user.access_interface set, extract IPv4, IPv6 and
-		// MAC addresses from that network interface.
-		net, ok := instanceState.Network[accIface]
-		if ok {
-			state.MAC = types.StringValue(net.Hwaddr)
-			ipv4, ipv6 := findGlobalIPAddresses(net)
-
-			if ipv4 != "" {
-				state.IPv4 = types.StringValue(ipv4)
-			}
-
-			if ipv6 != "" {
-				state.IPv6 = types.StringValue(ipv6)
-			}
-		}
-	} else {
-		// Search for the first interface (alphabetically sorted) that has
-		// global IPv4 or IPv6 address.
-		for _, iface := range utils.SortMapKeys(instanceState.Network) {
-			if iface == "lo" {
-				continue
-			}
-
-			net := instanceState.Network[iface]
-			ipv4, ipv6 := findGlobalIPAddresses(net)
-			if ipv4 != "" || ipv6 != "" {
-				state.MAC = types.StringValue(net.Hwaddr)
-
-				if ipv4 != "" {
-					state.IPv4 = types.StringValue(ipv4)
-				}
-
-				if ipv6 != "" {
-					state.IPv6 = types.StringValue(ipv6)
-				}
-
-				break
-			}
-		}
-	}
+	ipv4, ipv6, mac := findInstanceAddresses(instance.ExpandedConfig, instanceState.Network)
+	state.IPv4 = toNullableString(ipv4)
+	state.IPv6 = toNullableString(ipv6)
+	state.MAC = toNullableString(mac)
 
 	// Convert config, profiles, and devices into schema type.
 	config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(instance.Config), state.Config)
@@ -294,3 +250,47 @@ func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// findInstanceAddresses returns the IPv4, IPv6, and MAC addresses of the
+// instance's access interface. If "user.access_interface" is not configured,
+// the first interface (alphabetically sorted) that has a global IPv4 or IPv6
+// address is used.
+func findInstanceAddresses(config map[string]string, networks map[string]api.InstanceStateNetwork) (ipv4 string, ipv6 string, mac string) {
+	accIface, ok := config["user.access_interface"]
+	if ok {
+		// If there is an user.access_interface set, extract IPv4, IPv6 and
+		// MAC addresses from that network interface.
+		net, ok := networks[accIface]
+		if ok {
+			ipv4, ipv6 = findGlobalIPAddresses(net)
+			return ipv4, ipv6, net.Hwaddr
+		}
+
+		return "", "", ""
+	}
+
+	// Search for the first interface (alphabetically sorted) that has
+	// global IPv4 or IPv6 address.
+	for _, iface := range utils.SortMapKeys(networks) {
+		if iface == "lo" {
+			continue
+		}
+
+		net := networks[iface]
+		ipv4, ipv6 = findGlobalIPAddresses(net)
+		if ipv4 != "" || ipv6 != "" {
+			return ipv4, ipv6, net.Hwaddr
+		}
+	}
+
+	return "", "", ""
+}
+
+// toNullableString converts an empty string into a null string value.
+func toNullableString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
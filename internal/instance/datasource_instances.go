@@ -0,0 +1,310 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type InstancesDataSourceModel struct {
+	Filter      types.String `tfsdk:"filter"`
+	Project     types.String `tfsdk:"project"`
+	AllProjects types.Bool   `tfsdk:"all_projects"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Computed.
+	Instances []InstancesItemModel `tfsdk:"instances"`
+}
+
+type InstancesItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Project     types.String `tfsdk:"project"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	IPv4        types.String `tfsdk:"ipv4_address"`
+	IPv6        types.String `tfsdk:"ipv6_address"`
+	MAC         types.String `tfsdk:"mac_address"`
+	Location    types.String `tfsdk:"location"`
+	Status      types.String `tfsdk:"status"`
+	Ephemeral   types.Bool   `tfsdk:"ephemeral"`
+	Running     types.Bool   `tfsdk:"running"`
+	Profiles    types.List   `tfsdk:"profiles"`
+	Devices     types.Map    `tfsdk:"devices"`
+	Config      types.Map    `tfsdk:"config"`
+	Interfaces  types.Map    `tfsdk:"interfaces"`
+}
+
+type InstancesDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func NewInstancesDataSource() datasource.DataSource {
+	return &InstancesDataSource{}
+}
+
+func (d *InstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_instances", req.ProviderTypeName)
+}
+
+func (d *InstancesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Description: "LXD API filter expression, for example \"status eq Running\"",
+				Optional:    true,
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("all_projects")),
+				},
+			},
+
+			"all_projects": schema.BoolAttribute{
+				Description: "List instances from all projects",
+				Optional:    true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of instances matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"project": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"ephemeral": schema.BoolAttribute{
+							Computed: true,
+						},
+
+						"running": schema.BoolAttribute{
+							Computed: true,
+						},
+
+						"profiles": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"devices": schema.MapNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed: true,
+									},
+
+									"properties": schema.MapAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+
+						"interfaces": schema.MapNestedAttribute{
+							Computed:    true,
+							Description: "Map of the instance network interfaces",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+
+									"type": schema.StringAttribute{
+										Computed: true,
+									},
+
+									"state": schema.StringAttribute{
+										Computed: true,
+									},
+
+									"ips": schema.ListNestedAttribute{
+										Computed: true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"address": schema.StringAttribute{
+													Computed: true,
+												},
+
+												"family": schema.StringAttribute{
+													Computed: true,
+												},
+
+												"scope": schema.StringAttribute{
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"ipv4_address": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"ipv6_address": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"mac_address": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"location": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InstancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *InstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InstancesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := d.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if project == "" {
+		project = d.provider.Project(remote)
+	}
+
+	query := common.ListQuery{
+		Project:     project,
+		AllProjects: state.AllProjects.ValueBool(),
+		Recursion:   2,
+		Filter:      state.Filter.ValueString(),
+	}
+
+	// Instances are retrieved with recursion level 2, which includes
+	// the instance state (network interfaces).
+	var instances []api.InstanceFull
+	err = common.QueryList(server, "/1.0/instances", query, &instances)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve instances", err.Error())
+		return
+	}
+
+	state.Instances = make([]InstancesItemModel, 0, len(instances))
+	for _, instance := range instances {
+		networks := map[string]api.InstanceStateNetwork{}
+		status := instance.Status
+		if instance.State != nil {
+			networks = instance.State.Network
+			status = instance.State.Status
+		}
+
+		config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(instance.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		profiles, diags := ToProfileListType(ctx, instance.Profiles)
+		resp.Diagnostics.Append(diags...)
+
+		devices, diags := common.ToDeviceMapType(ctx, instance.Devices)
+		resp.Diagnostics.Append(diags...)
+
+		interfaces, diags := common.ToInterfaceMapType(ctx, networks, instance.Config)
+		resp.Diagnostics.Append(diags...)
+
+		ipv4, ipv6, mac := findInstanceAddresses(instance.ExpandedConfig, networks)
+
+		instanceProject := instance.Project
+		if instanceProject == "" {
+			instanceProject = project
+		}
+
+		state.Instances = append(state.Instances, InstancesItemModel{
+			Name:        types.StringValue(instance.Name),
+			Project:     types.StringValue(instanceProject),
+			Description: types.StringValue(instance.Description),
+			Type:        types.StringValue(instance.Type),
+			IPv4:        toNullableString(ipv4),
+			IPv6:        toNullableString(ipv6),
+			MAC:         toNullableString(mac),
+			Location:    types.StringValue(instance.Location),
+			Status:      types.StringValue(instance.Status),
+			Ephemeral:   types.BoolValue(instance.Ephemeral),
+			Running:     types.BoolValue(status == api.Running.String()),
+			Profiles:    profiles,
+			Devices:     devices,
+			Config:      config,
+			Interfaces:  interfaces,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,126 @@
+package instance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccInstances_DS_filter(t *testing.T) {
+	role := acctest.GenerateName(2, "-")
+	webName := acctest.GenerateName(2, "-")
+	dbName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstances_DS_filter(role, webName, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.0.name", webName),
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.0.project", "default"),
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.0.status", "Stopped"),
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.0.running", "false"),
+					resource.TestCheckResourceAttr("data.lxd_instances.web", "instances.0.config.user.role", role+"-web"),
+					resource.TestCheckResourceAttr("data.lxd_instances.all", "instances.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstances_DS_allProjects(t *testing.T) {
+	role := acctest.GenerateName(2, "-")
+	projectName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstances_DS_allProjects(role, projectName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_instances.all", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_instances.all", "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr("data.lxd_instances.all", "instances.0.project", projectName),
+					resource.TestCheckResourceAttr("data.lxd_instances.default", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstances_DS_filter(role string, webName string, dbName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "web" {
+  name    = %[2]q
+  running = false
+
+  config = {
+    "user.role" = "%[1]s-web"
+  }
+}
+
+resource "lxd_instance" "db" {
+  name    = %[3]q
+  running = false
+
+  config = {
+    "user.role" = "%[1]s-db"
+  }
+}
+
+data "lxd_instances" "web" {
+  filter = "config.user.role eq %[1]s-web"
+
+  depends_on = [lxd_instance.web, lxd_instance.db]
+}
+
+data "lxd_instances" "all" {
+  filter = "config.user.role eq %[1]s-web or config.user.role eq %[1]s-db"
+
+  depends_on = [lxd_instance.web, lxd_instance.db]
+}
+`, role, webName, dbName)
+}
+
+func testAccInstances_DS_allProjects(role string, projectName string, instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_project" "project" {
+  name = %[2]q
+
+  config = {
+    "features.images"   = false
+    "features.profiles" = false
+  }
+}
+
+resource "lxd_instance" "inst" {
+  name    = %[3]q
+  project = lxd_project.project.name
+  running = false
+
+  config = {
+    "user.role" = %[1]q
+  }
+}
+
+data "lxd_instances" "all" {
+  all_projects = true
+  filter       = "config.user.role eq %[1]s"
+
+  depends_on = [lxd_instance.inst]
+}
+
+data "lxd_instances" "default" {
+  filter = "config.user.role eq %[1]s"
+
+  depends_on = [lxd_instance.inst]
+}
+`, role, projectName, instanceName)
+}
@@ -3,8 +3,11 @@ package instance
 import (
 	"context"
 	"fmt"
+	"net"
+	"os"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,8 +15,10 @@ import (
 	"github.com/canonical/lxd/shared/api"
 	"github.com/canonical/lxd/shared/units"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
@@ -41,6 +48,8 @@ type InstanceModel struct {
 	Description    types.String `tfsdk:"description"`
 	Type           types.String `tfsdk:"type"`
 	Image          types.String `tfsdk:"image"`
+	SourceBackup   types.String `tfsdk:"source_backup"`
+	SourceInstance types.Object `tfsdk:"source_instance"`
 	Ephemeral      types.Bool   `tfsdk:"ephemeral"`
 	Running        types.Bool   `tfsdk:"running"`
 	AllowRestart   types.Bool   `tfsdk:"allow_restart"`
@@ -54,18 +63,34 @@ type InstanceModel struct {
 	Remote         types.String `tfsdk:"remote"`
 	Target         types.String `tfsdk:"target"`
 
+	// Write-only.
+	FileContentWO        types.Map   `tfsdk:"file_content_wo"`
+	FileContentWOVersion types.Int64 `tfsdk:"file_content_wo_version"`
+
 	// Computed.
-	IPv4       types.String `tfsdk:"ipv4_address"`
-	IPv6       types.String `tfsdk:"ipv6_address"`
-	MAC        types.String `tfsdk:"mac_address"`
-	Location   types.String `tfsdk:"location"`
-	Status     types.String `tfsdk:"status"`
-	Interfaces types.Map    `tfsdk:"interfaces"`
+	IPv4              types.String `tfsdk:"ipv4_address"`
+	IPv6              types.String `tfsdk:"ipv6_address"`
+	MAC               types.String `tfsdk:"mac_address"`
+	Location          types.String `tfsdk:"location"`
+	Status            types.String `tfsdk:"status"`
+	Interfaces        types.Map    `tfsdk:"interfaces"`
+	FileContentSHA256 types.Map    `tfsdk:"file_content_sha256"`
 
 	// Timeouts.
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// SourceInstanceModel represents the instance or snapshot from which the
+// instance is copied.
+type SourceInstanceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Snapshot     types.String `tfsdk:"snapshot"`
+	Project      types.String `tfsdk:"project"`
+	Remote       types.String `tfsdk:"remote"`
+	InstanceOnly types.Bool   `tfsdk:"instance_only"`
+	Refresh      types.Bool   `tfsdk:"refresh"`
+}
+
 func (m InstanceModel) IsContainer() bool {
 	return m.Type.ValueString() == "container"
 }
@@ -76,9 +101,13 @@ func (m InstanceModel) IsVirtualMachine() bool {
 
 // WaitForModel represents a single wait_for block.
 type WaitForModel struct {
-	Type  types.String `tfsdk:"type"`
-	Delay types.String `tfsdk:"delay"`
-	Nic   types.String `tfsdk:"nic"`
+	Type     types.String `tfsdk:"type"`
+	Delay    types.String `tfsdk:"delay"`
+	Nic      types.String `tfsdk:"nic"`
+	Port     types.Int64  `tfsdk:"port"`
+	Command  types.List   `tfsdk:"command"`
+	Interval types.String `tfsdk:"interval"`
+	Retries  types.Int64  `tfsdk:"retries"`
 }
 
 func (m WaitForModel) IsAgent() bool {
@@ -101,6 +130,18 @@ func (m WaitForModel) IsReady() bool {
 	return m.Type.ValueString() == "ready"
 }
 
+func (m WaitForModel) IsCloudInit() bool {
+	return m.Type.ValueString() == "cloud_init"
+}
+
+func (m WaitForModel) IsPort() bool {
+	return m.Type.ValueString() == "port"
+}
+
+func (m WaitForModel) IsExec() bool {
+	return m.Type.ValueString() == "exec"
+}
+
 // InstanceResource represent LXD instance resource.
 type InstanceResource struct {
 	provider *provider_config.LxdProviderConfig
@@ -147,6 +188,80 @@ func (r InstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 
+			"source_backup": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("image")),
+				},
+			},
+
+			"source_instance": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+
+					"snapshot": schema.StringAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+
+					"project": schema.StringAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+
+					"remote": schema.StringAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+
+					"instance_only": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+
+					"refresh": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("image"),
+						path.MatchRoot("source_backup"),
+					),
+				},
+			},
+
 			"ephemeral": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -184,7 +299,6 @@ func (r InstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -326,8 +440,27 @@ func (r InstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 
+			"file_content_wo": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Description: "Write-only content of the uploaded files, keyed by the file target path",
+			},
+
+			"file_content_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the write-only file content. Changing the version re-uploads the files",
+			},
+
 			// Computed.
 
+			"file_content_sha256": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "SHA-256 checksums of the write-only file content, keyed by the file target path. Used to detect changes of the files",
+			},
+
 			"interfaces": schema.MapNestedAttribute{
 				Computed:    true,
 				Description: "Map of the instance network interfaces",
@@ -408,9 +541,12 @@ func (r InstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 							Validators: []validator.String{
 								stringvalidator.OneOf(
 									"agent",
+									"cloud_init",
 									"delay",
+									"exec",
 									"ipv4",
 									"ipv6",
+									"port",
 									"ready",
 								),
 							},
@@ -423,6 +559,36 @@ func (r InstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 						"nic": schema.StringAttribute{
 							Optional: true,
 						},
+
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "TCP port that must accept connections",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+
+						"command": schema.ListAttribute{
+							Optional:    true,
+							Description: "Command that must exit successfully",
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+
+						"interval": schema.StringAttribute{
+							Optional:    true,
+							Description: "Initial interval between command attempts",
+						},
+
+						"retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of command retries",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
 					},
 				},
 			},
@@ -536,6 +702,93 @@ func (r *InstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	if !req.Config.Raw.IsNull() && config.Profiles.IsNull() {
 		resp.Plan.SetAttribute(ctx, path.Root("profiles"), []string{"default"})
 	}
+
+	if !req.Plan.Raw.IsNull() {
+		modifyPlanFileContentWO(ctx, req, resp)
+	}
+
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
+// modifyPlanFileContentWO sets the checksums of the write-only file content
+// in the plan. Write-only content is not stored, therefore its checksums are
+// compared with the checksums in the state to detect changes of the files.
+func modifyPlanFileContentWO(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var contentWO types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("file_content_wo"), &contentWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if contentWO.IsNull() {
+		resp.Plan.SetAttribute(ctx, path.Root("file_content_sha256"), types.MapNull(types.StringType))
+		return
+	}
+
+	// Unknown write-only content is applied only when the version changes.
+	if !isMapKnown(contentWO) {
+		if req.State.Raw.IsNull() {
+			return
+		}
+
+		var planVersion types.Int64
+		var state InstanceModel
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file_content_wo_version"), &planVersion)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.FileContentWOVersion.Equal(planVersion) {
+			resp.Plan.SetAttribute(ctx, path.Root("file_content_sha256"), state.FileContentSHA256)
+		}
+
+		return
+	}
+
+	checksums, diags := fileContentChecksums(ctx, contentWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Plan.SetAttribute(ctx, path.Root("file_content_sha256"), checksums)
+}
+
+// fileContentChecksums returns the SHA-256 checksums of the write-only file
+// content, keyed by the file target path.
+func fileContentChecksums(ctx context.Context, contentWO types.Map) (types.Map, diag.Diagnostics) {
+	if contentWO.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+
+	contents, diags := common.ToConfigMap(ctx, contentWO)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	checksums := make(map[string]string, len(contents))
+	for targetPath, content := range contents {
+		checksums[targetPath] = contentChecksum([]byte(content))
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, checksums)
+}
+
+// isMapKnown returns true if the map and all of its elements are known.
+func isMapKnown(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (r InstanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -572,11 +825,11 @@ func (r InstanceResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 
 	// Ensure empty container cannot be started.
-	if running && !config.Image.IsUnknown() && config.Image.ValueString() == "" && config.Type.ValueString() == "container" {
+	if running && !config.Image.IsUnknown() && config.Image.ValueString() == "" && config.SourceBackup.IsNull() && config.SourceInstance.IsNull() && config.Type.ValueString() == "container" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("image"),
 			fmt.Sprintf("Instance %q is a container and requires image", config.Name.ValueString()),
-			`Container instances require a rootfs (image) to be started, therefore attribute "image" must be set.`,
+			`Container instances require a rootfs (image) to be started, therefore attribute "image", "source_backup", or "source_instance" must be set.`,
 		)
 	}
 
@@ -584,6 +837,10 @@ func (r InstanceResource) ValidateConfig(ctx context.Context, req resource.Valid
 		validateWaitFor(ctx, config, resp)
 	}
 
+	if !config.FileContentWO.IsNull() {
+		validateFileContentWO(ctx, config, resp)
+	}
+
 	if config.IsVirtualMachine() {
 		if !config.Files.IsNull() {
 			validateWaitForAgent(ctx, config, resp, `Wait for "agent" is required when files are uploaded to a virtual machine.`)
@@ -595,6 +852,71 @@ func (r InstanceResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
+// validateFileContentWO ensures that write-only content is provided only
+// for the uploaded files that do not set the content or source path.
+func validateFileContentWO(ctx context.Context, config InstanceModel, resp *resource.ValidateConfigResponse) {
+	// Files may not be known yet.
+	if config.Files.IsUnknown() {
+		return
+	}
+
+	files, diags := common.ToFileMap(ctx, config.Files)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	for targetPath := range config.FileContentWO.Elements() {
+		f, ok := files[targetPath]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file_content_wo"),
+				"Invalid Configuration",
+				fmt.Sprintf("Write-only content is set for file %q, which is not uploaded to the instance.", targetPath),
+			)
+
+			continue
+		}
+
+		if !f.Content.IsNull() || !f.SourcePath.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file_content_wo"),
+				"Invalid Configuration",
+				fmt.Sprintf("Write-only content of file %q cannot be used together with %q or %q.", targetPath, "content", "source_path"),
+			)
+		}
+	}
+}
+
+// setFileContentWO sets the write-only content from the configuration on
+// the files with the matching target path. It returns the checksums of the
+// write-only content.
+func setFileContentWO(ctx context.Context, config tfsdk.Config, files map[string]common.InstanceFileModel) (types.Map, diag.Diagnostics) {
+	var contentWO types.Map
+
+	diags := config.GetAttribute(ctx, path.Root("file_content_wo"), &contentWO)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	contents, diags := common.ToConfigMap(ctx, contentWO)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	for targetPath, content := range contents {
+		f, ok := files[targetPath]
+		if !ok {
+			continue
+		}
+
+		f.Content = types.StringValue(content)
+		files[targetPath] = f
+	}
+
+	return fileContentChecksums(ctx, contentWO)
+}
+
 // validateWaitFor validates the wait_for configuration blocks.
 func validateWaitFor(ctx context.Context, config InstanceModel, resp *resource.ValidateConfigResponse) {
 	waitForList := make([]WaitForModel, 0, 1)
@@ -605,11 +927,11 @@ func validateWaitFor(ctx context.Context, config InstanceModel, resp *resource.V
 	}
 
 	for _, waitFor := range waitForList {
-		// "nic" is only valid for ipv4/ipv6.
-		if !waitFor.IsIPv4() && !waitFor.IsIPv6() && !waitFor.Nic.IsNull() {
+		// "nic" is only valid for ipv4/ipv6/port.
+		if !waitFor.IsIPv4() && !waitFor.IsIPv6() && !waitFor.IsPort() && !waitFor.Nic.IsNull() {
 			resp.Diagnostics.AddError(
 				"Invalid Configuration",
-				`The "nic" can only be set when wait_for type is "ipv4" or "ipv6".`,
+				`The "nic" can only be set when wait_for type is "ipv4", "ipv6", or "port".`,
 			)
 		}
 
@@ -643,6 +965,55 @@ func validateWaitFor(ctx context.Context, config InstanceModel, resp *resource.V
 				`The "delay" attribute can only be set when wait_for type is "delay".`,
 			)
 		}
+
+		// "port" requires the port attribute.
+		if waitFor.IsPort() && waitFor.Port.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				`The "port" attribute is required when wait_for type is "port".`,
+			)
+		}
+
+		// "port" attribute is only valid for the "port" type.
+		if !waitFor.IsPort() && !waitFor.Port.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				`The "port" attribute can only be set when wait_for type is "port".`,
+			)
+		}
+
+		// "exec" requires the command attribute.
+		if waitFor.IsExec() && waitFor.Command.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				`The "command" attribute is required when wait_for type is "exec".`,
+			)
+		}
+
+		// "interval" must be a positive duration.
+		if !waitFor.Interval.IsNull() && !waitFor.Interval.IsUnknown() {
+			interval, err := time.ParseDuration(waitFor.Interval.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid Configuration",
+					fmt.Sprintf("Invalid wait_for interval duration %q: %v.", waitFor.Interval.ValueString(), err),
+				)
+			} else if interval <= 0 {
+				resp.Diagnostics.AddError(
+					"Invalid Configuration",
+					fmt.Sprintf("Invalid wait_for interval duration %q: Interval must be positive.", waitFor.Interval.ValueString()),
+				)
+			}
+		}
+
+		// "command", "interval", and "retries" attributes are only valid
+		// for the "exec" type.
+		if !waitFor.IsExec() && (!waitFor.Command.IsNull() || !waitFor.Interval.IsNull() || !waitFor.Retries.IsNull()) {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				`The "command", "interval", and "retries" attributes can only be set when wait_for type is "exec".`,
+			)
+		}
 	}
 }
 
@@ -696,16 +1067,10 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var imageRemote string
 	var imageServer lxd.ImageServer
 
 	// Evaluate image remote.
-	image := plan.Image.ValueString()
-	imageParts := strings.SplitN(image, ":", 2)
-	if len(imageParts) == 2 {
-		imageRemote = imageParts[0]
-		image = imageParts[1]
-	}
+	imageRemote, image := common.ParseImageRef(plan.Image.ValueString())
 
 	if imageRemote == "" {
 		// Use the instance server as an image server if image remote is empty.
@@ -780,18 +1145,57 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	// In case the image is set, create the instance from it, otherwise create it without rootfs. Similar to the --empty CLI flag on lxc.
-	if image != "" {
+	// In case the backup is set, restore the instance from it. In case the source instance
+	// is set, copy the instance from it. In case the image is set, create the instance from it,
+	// otherwise create it without rootfs. Similar to the --empty CLI flag on lxc.
+	//
+	// Instances restored from the backup or copied from the source instance keep
+	// their original configuration, which is replaced once the instance is
+	// tracked in the state.
+	replaceConfig := false
+	if plan.SourceBackup.ValueString() != "" {
+		err = createInstanceFromBackup(ctx, server, plan.SourceBackup.ValueString(), instance.Name)
+		replaceConfig = true
+	} else if !plan.SourceInstance.IsNull() {
+		var source SourceInstanceModel
+
+		diags = plan.SourceInstance.As(ctx, &source, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		// Source instance defaults to the remote and project of the new instance.
+		sourceRemote := remote
+		if !source.Remote.IsNull() {
+			sourceRemote = source.Remote.ValueString()
+		}
+
+		sourceProject := project
+		if !source.Project.IsNull() {
+			sourceProject = source.Project.ValueString()
+		}
+
+		var sourceServer lxd.InstanceServer
+		sourceServer, err = r.provider.InstanceServer(sourceRemote, sourceProject, "")
+		if err != nil {
+			resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+			return
+		}
+
+		err = createInstanceFromSource(ctx, server, sourceServer, source, instance)
+		replaceConfig = true
+	} else if image != "" {
 		var opCreateFromImage lxd.RemoteOperation
 		opCreateFromImage, err = server.CreateInstanceFromImage(imageServer, *imageInfo, instance)
 		if err == nil {
-			err = opCreateFromImage.Wait()
+			err = common.WaitRemoteOperation(ctx, opCreateFromImage)
 		}
 	} else {
 		var opCreate lxd.Operation
 		opCreate, err = server.CreateInstance(instance)
 		if err == nil {
-			err = opCreate.Wait()
+			err = opCreate.WaitContext(ctx)
 		}
 	}
 
@@ -806,9 +1210,17 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if replaceConfig {
+		err = replaceInstanceConfig(ctx, server, instance, plan.ComputedKeys())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update configuration of instance %q", instance.Name), err.Error())
+			return
+		}
+	}
+
 	if plan.Running.ValueBool() {
 		// Start the instance.
-		diag := startInstance(ctx, server, instance.Name)
+		diag := startInstance(ctx, server, r.provider.InstanceEvents(remote), instance.Name)
 		if diag != nil {
 			resp.Diagnostics.Append(diag)
 			return
@@ -816,7 +1228,7 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 
 		// Take the wait_for configurations into account.
 		if len(plan.WaitForConfigs.Elements()) > 0 {
-			diags := waitFor(ctx, server, instance.Name, plan.WaitForConfigs)
+			diags := waitFor(ctx, server, r.provider.InstanceEvents(remote), instance.Name, plan.WaitForConfigs)
 			if diags != nil {
 				resp.Diagnostics.Append(diags...)
 				return
@@ -825,6 +1237,7 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Upload files.
+	plan.FileContentSHA256 = types.MapNull(types.StringType)
 	if !plan.Files.IsNull() && !plan.Files.IsUnknown() {
 		files, diags := common.ToFileMap(ctx, plan.Files)
 		if diags.HasError() {
@@ -832,6 +1245,12 @@ func (r InstanceResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
+		plan.FileContentSHA256, diags = setFileContentWO(ctx, req.Config, files)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
 		for _, f := range files {
 			err := common.InstanceFileUpload(server, instance.Name, f)
 			if err != nil {
@@ -1028,7 +1447,7 @@ func (r InstanceResource) Update(ctx context.Context, req resource.UpdateRequest
 				return
 			}
 
-			_, diag := stopInstance(ctx, server, instanceName, false)
+			_, diag := stopInstance(ctx, server, r.provider.InstanceEvents(remote), instanceName, false)
 			if diag != nil {
 				resp.Diagnostics.Append(diag)
 				return
@@ -1078,7 +1497,7 @@ func (r InstanceResource) Update(ctx context.Context, req resource.UpdateRequest
 	opUpdate, err := server.UpdateInstance(instanceName, newInstance, etag)
 	if err == nil {
 		// Wait for the instance to be updated.
-		err = opUpdate.Wait()
+		err = opUpdate.WaitContext(ctx)
 	}
 
 	if err != nil {
@@ -1112,7 +1531,7 @@ func (r InstanceResource) Update(ctx context.Context, req resource.UpdateRequest
 		instanceStarted = true
 		instanceStopped = false
 
-		diag := startInstance(ctx, server, instanceName)
+		diag := startInstance(ctx, server, r.provider.InstanceEvents(remote), instanceName)
 		if diag != nil {
 			resp.Diagnostics.Append(diag)
 			return
@@ -1120,7 +1539,7 @@ func (r InstanceResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		// If instance is freshly started, we also take the wait for configurations into account.
 		if len(plan.WaitForConfigs.Elements()) > 0 {
-			diags := waitFor(ctx, server, instanceName, plan.WaitForConfigs)
+			diags := waitFor(ctx, server, r.provider.InstanceEvents(remote), instanceName, plan.WaitForConfigs)
 			if diags != nil {
 				resp.Diagnostics.Append(diags...)
 				return
@@ -1138,6 +1557,12 @@ func (r InstanceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	plan.FileContentSHA256, diags = setFileContentWO(ctx, req.Config, newFiles)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	// Remove files that are no longer present in newFiles.
 	for _, f := range oldFiles {
 		targetPath := f.TargetPath.ValueString()
@@ -1228,7 +1653,7 @@ func (r InstanceResource) Delete(ctx context.Context, req resource.DeleteRequest
 	instanceName := state.Name.ValueString()
 
 	// Force stop the instance, because we are deleting it anyway.
-	isFound, diag := stopInstance(ctx, server, instanceName, true)
+	isFound, diag := stopInstance(ctx, server, r.provider.InstanceEvents(remote), instanceName, true)
 	if diag != nil {
 		// Ephemeral instances will be removed when stopped.
 		if !isFound {
@@ -1265,7 +1690,7 @@ func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	if fields["project"] == "" {
-		fields["project"] = provider_config.DefaultProject
+		fields["project"] = r.provider.Project(fields["remote"])
 	}
 
 	for k, v := range fields {
@@ -1480,7 +1905,7 @@ func ToProfileListType(ctx context.Context, profiles []string) (types.List, diag
 
 // startInstance starts an instance with the given name. It also waits
 // for it to become fully operational.
-func startInstance(ctx context.Context, server lxd.InstanceServer, instanceName string) diag.Diagnostic {
+func startInstance(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string) diag.Diagnostic {
 	st, etag, err := server.GetInstanceState(instanceName)
 	if err != nil {
 		return diag.NewErrorDiagnostic(fmt.Sprintf("Failed to retrieve state of instance %q", instanceName), err.Error())
@@ -1518,7 +1943,7 @@ func startInstance(ctx context.Context, server lxd.InstanceServer, instanceName
 
 	// Even though op.Wait has completed, wait until we can see
 	// the instance is started via a new API call.
-	_, err = waitForState(ctx, instanceStartedCheck, api.Running.String(), api.Ready.String())
+	_, err = waitForState(ctx, server, events, instanceName, instanceStartedCheck, api.Running.String(), api.Ready.String())
 	if err != nil {
 		return diag.NewErrorDiagnostic(fmt.Sprintf("Failed to wait for instance %q to start", instanceName), err.Error())
 	}
@@ -1530,7 +1955,7 @@ func startInstance(ctx context.Context, server lxd.InstanceServer, instanceName
 // status to become Stopped or the instance to be removed (not found) in
 // case of an ephemeral instance. In the latter case, false is returned
 // along an error.
-func stopInstance(ctx context.Context, server lxd.InstanceServer, instanceName string, force bool) (bool, diag.Diagnostic) {
+func stopInstance(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, force bool) (bool, diag.Diagnostic) {
 	st, etag, err := server.GetInstanceState(instanceName)
 	if err != nil {
 		return true, diag.NewErrorDiagnostic(fmt.Sprintf("Failed to retrieve state of instance %q", instanceName), err.Error())
@@ -1568,7 +1993,7 @@ func stopInstance(ctx context.Context, server lxd.InstanceServer, instanceName s
 
 	// Even though op.Wait has completed, wait until we can see
 	// the instance is stopped via a new API call.
-	_, err = waitForState(ctx, instanceStoppedCheck, api.Stopped.String())
+	_, err = waitForState(ctx, server, events, instanceName, instanceStoppedCheck, api.Stopped.String())
 	if err != nil {
 		found := !errors.IsNotFoundError(err)
 		return found, diag.NewErrorDiagnostic(fmt.Sprintf("Failed to wait for instance %q to stop", instanceName), err.Error())
@@ -1577,6 +2002,101 @@ func stopInstance(ctx context.Context, server lxd.InstanceServer, instanceName s
 	return true, nil
 }
 
+// createInstanceFromBackup creates an instance from the local backup file.
+// The instance keeps the configuration restored from the backup.
+func createInstanceFromBackup(ctx context.Context, server lxd.InstanceServer, backupPath string, instanceName string) error {
+	file, err := os.Open(backupPath)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	backupArgs := lxd.InstanceBackupArgs{
+		BackupFile: file,
+		Name:       instanceName,
+	}
+
+	op, err := server.CreateInstanceFromBackup(backupArgs)
+	if err != nil {
+		return err
+	}
+
+	return op.WaitContext(ctx)
+}
+
+// createInstanceFromSource copies an instance or its snapshot from the source
+// server. The copied instance keeps the configuration of the source.
+func createInstanceFromSource(ctx context.Context, server lxd.InstanceServer, sourceServer lxd.InstanceServer, source SourceInstanceModel, instance api.InstancesPost) error {
+	sourceName := source.Name.ValueString()
+	sourceInstance, _, err := sourceServer.GetInstance(sourceName)
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve source instance %q: %w", sourceName, err)
+	}
+
+	// The type of the copied instance cannot be changed.
+	if sourceInstance.Type != string(instance.Type) {
+		return fmt.Errorf("Source instance %q is of type %q, but instance %q is of type %q", sourceName, sourceInstance.Type, instance.Name, instance.Type)
+	}
+
+	var op lxd.RemoteOperation
+	if source.Snapshot.IsNull() {
+		args := lxd.InstanceCopyArgs{
+			Name:         instance.Name,
+			InstanceOnly: source.InstanceOnly.ValueBool(),
+			Refresh:      source.Refresh.ValueBool(),
+		}
+
+		op, err = server.CopyInstance(sourceServer, *sourceInstance, &args)
+	} else {
+		var snapshot *api.InstanceSnapshot
+
+		snapshotName := source.Snapshot.ValueString()
+		snapshot, _, err = sourceServer.GetInstanceSnapshot(sourceName, snapshotName)
+		if err != nil {
+			return fmt.Errorf("Failed to retrieve snapshot %q of source instance %q: %w", snapshotName, sourceName, err)
+		}
+
+		args := lxd.InstanceSnapshotCopyArgs{
+			Name: instance.Name,
+		}
+
+		op, err = server.CopyInstanceSnapshot(sourceServer, sourceName, *snapshot, &args)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return common.WaitRemoteOperation(ctx, op)
+}
+
+// replaceInstanceConfig replaces the configuration of an existing instance
+// with the given one, except for the computed configuration keys.
+func replaceInstanceConfig(ctx context.Context, server lxd.InstanceServer, instance api.InstancesPost, computedKeys []string) error {
+	current, etag, err := server.GetInstance(instance.Name)
+	if err != nil {
+		return err
+	}
+
+	newInstance := api.InstancePut{
+		Description:  instance.Description,
+		Ephemeral:    instance.Ephemeral,
+		Architecture: current.Architecture,
+		Stateful:     current.Stateful,
+		Config:       common.MergeConfig(current.Config, instance.Config, computedKeys),
+		Profiles:     instance.Profiles,
+		Devices:      instance.Devices,
+	}
+
+	opUpdate, err := server.UpdateInstance(instance.Name, newInstance, etag)
+	if err != nil {
+		return err
+	}
+
+	return opUpdate.WaitContext(ctx)
+}
+
 // renameInstance renames an instance with the given old name to a new name.
 // Instance has to be stopped beforehand, otherwise the operation will fail.
 func renameInstance(ctx context.Context, server lxd.InstanceServer, oldName string, newName string) error {
@@ -1610,7 +2130,7 @@ func migrateInstance(ctx context.Context, server lxd.InstanceServer, instanceNam
 // waitFor waits for the instance with the given name to reach the desired
 // state. It returns an error if the instance does not reach the desired
 // state within the given timeout.
-func waitFor(ctx context.Context, server lxd.InstanceServer, instanceName string, waitForSet types.Set) diag.Diagnostics {
+func waitFor(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, waitForSet types.Set) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	waitForList, d := ToWaitForList(ctx, waitForSet)
@@ -1624,15 +2144,23 @@ func waitFor(ctx context.Context, server lxd.InstanceServer, instanceName string
 
 		switch waitForType {
 		case "agent":
-			d = waitForInstanceAgent(ctx, server, instanceName)
+			d = waitForInstanceAgent(ctx, server, events, instanceName)
+		case "cloud_init":
+			d = waitForInstanceCloudInit(ctx, server, events, instanceName)
 		case "delay":
 			duration := waitForModel.Delay.ValueString()
 			d = waitForInstanceWithDelay(ctx, instanceName, duration)
 		case "ipv4", "ipv6":
 			nic := waitForModel.Nic.ValueString()
-			d = waitForInstanceNetwork(ctx, server, instanceName, waitForType, nic)
+			d = waitForInstanceNetwork(ctx, server, events, instanceName, waitForType, nic)
+		case "port":
+			nic := waitForModel.Nic.ValueString()
+			port := waitForModel.Port.ValueInt64()
+			d = waitForInstancePort(ctx, server, events, instanceName, port, nic)
+		case "exec":
+			d = waitForInstanceExec(ctx, server, events, instanceName, waitForModel)
 		case "ready":
-			d = waitForInstanceToBeReady(ctx, server, instanceName)
+			d = waitForInstanceToBeReady(ctx, server, events, instanceName)
 		default:
 			d.AddError(fmt.Sprintf("Invalid value for wait_for: %q", waitForType), "")
 		}
@@ -1648,7 +2176,7 @@ func waitFor(ctx context.Context, server lxd.InstanceServer, instanceName string
 
 // waitForInstanceCondition polls the instance state until the given condition
 // returns true, or until the timeout is reached.
-func waitForInstanceCondition(ctx context.Context, server lxd.InstanceServer, instanceName string, condition func(api.InstanceState) bool) error {
+func waitForInstanceCondition(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, condition func(api.InstanceState) bool) error {
 	check := func() (any, string, error) {
 		state, _, err := server.GetInstanceState(instanceName)
 		if err != nil {
@@ -1662,14 +2190,14 @@ func waitForInstanceCondition(ctx context.Context, server lxd.InstanceServer, in
 		return state, "Waiting", nil
 	}
 
-	_, err := waitForState(ctx, check, "OK")
+	_, err := waitForState(ctx, server, events, instanceName, check, "OK")
 	return err
 }
 
 // waitForInstanceAgent waits for the LXD agent to be fully operational
 // within the instance.
-func waitForInstanceAgent(ctx context.Context, server lxd.InstanceServer, instanceName string) diag.Diagnostics {
-	err := waitForInstanceCondition(ctx, server, instanceName, isInstanceOperational)
+func waitForInstanceAgent(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string) diag.Diagnostics {
+	err := waitForInstanceCondition(ctx, server, events, instanceName, isInstanceOperational)
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(fmt.Sprintf("Failed to wait for instance %q agent to be ready", instanceName), err.Error())
@@ -1702,25 +2230,14 @@ func waitForInstanceWithDelay(ctx context.Context, instanceName string, delay st
 // address matching the given IP family. If nic is specified, only that
 // interface is checked. Otherwise, the "user.access_interface" config
 // key is consulted, falling back to any non-loopback interface.
-func waitForInstanceNetwork(ctx context.Context, server lxd.InstanceServer, instanceName string, ipFamily string, nic string) diag.Diagnostics {
+func waitForInstanceNetwork(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, ipFamily string, nic string) diag.Diagnostics {
 	if ipFamily != "ipv4" && ipFamily != "ipv6" {
 		var diags diag.Diagnostics
 		diags.AddError(fmt.Sprintf("Invalid IP family %q for instance %q", ipFamily, instanceName), "Only \"ipv4\" and \"ipv6\" are supported.")
 		return diags
 	}
 
-	// If no explicit NIC is provided, check the instance config for
-	// "user.access_interface" to match the behavior of ipv4/ipv6_address
-	// attribute reporting.
-	if nic == "" {
-		inst, _, err := server.GetInstance(instanceName)
-		if err == nil {
-			accIface, ok := inst.ExpandedConfig["user.access_interface"]
-			if ok {
-				nic = accIface
-			}
-		}
-	}
+	nic = instanceAccessInterface(server, instanceName, nic)
 
 	condition := func(s api.InstanceState) bool {
 		for iface, net := range s.Network {
@@ -1739,7 +2256,7 @@ func waitForInstanceNetwork(ctx context.Context, server lxd.InstanceServer, inst
 		return false
 	}
 
-	err := waitForInstanceCondition(ctx, server, instanceName, condition)
+	err := waitForInstanceCondition(ctx, server, events, instanceName, condition)
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(fmt.Sprintf("Failed to wait for instance %q to get an IP address", instanceName), err.Error())
@@ -1749,9 +2266,199 @@ func waitForInstanceNetwork(ctx context.Context, server lxd.InstanceServer, inst
 	return nil
 }
 
+// instanceAccessInterface returns the network interface through which the
+// instance is accessed. If no explicit NIC is provided, the instance config
+// is checked for "user.access_interface" to match the behavior of
+// ipv4/ipv6_address attribute reporting. An empty string means that any
+// network interface can be used.
+func instanceAccessInterface(server lxd.InstanceServer, instanceName string, nic string) string {
+	if nic != "" {
+		return nic
+	}
+
+	inst, _, err := server.GetInstance(instanceName)
+	if err == nil {
+		accIface, ok := inst.ExpandedConfig["user.access_interface"]
+		if ok {
+			return accIface
+		}
+	}
+
+	return ""
+}
+
+// waitForInstancePort waits for the given TCP port to accept connections on
+// the instance global IP address. The IPv4 address is preferred over the IPv6
+// one. The connection is established from the machine running Terraform.
+func waitForInstancePort(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, port int64, nic string) diag.Diagnostics {
+	nic = instanceAccessInterface(server, instanceName, nic)
+
+	check := func() (any, string, error) {
+		state, _, err := server.GetInstanceState(instanceName)
+		if err != nil {
+			return state, "Error", err
+		}
+
+		for iface, network := range state.Network {
+			if iface == "lo" || (nic != "" && nic != iface) {
+				continue
+			}
+
+			ipv4, ipv6 := findGlobalIPAddresses(network)
+			for _, ip := range []string{ipv4, ipv6} {
+				if ip == "" {
+					continue
+				}
+
+				dialer := net.Dialer{Timeout: 5 * time.Second}
+				conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip, strconv.FormatInt(port, 10)))
+				if err == nil {
+					_ = conn.Close()
+					return state, "OK", nil
+				}
+			}
+		}
+
+		return state, "Waiting", nil
+	}
+
+	_, err := waitForState(ctx, server, events, instanceName, check, "OK")
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(fmt.Sprintf("Failed to wait for port %d on instance %q to accept connections", port, instanceName), err.Error())
+		return diags
+	}
+
+	return nil
+}
+
+// waitForInstanceCloudInit waits for cloud-init to finish within the
+// instance. Recoverable cloud-init errors are reported as a warning.
+func waitForInstanceCloudInit(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string) diag.Diagnostics {
+	// Commands can be executed in virtual machines only once the
+	// LXD agent is running.
+	diags := waitForInstanceAgent(ctx, server, events, instanceName)
+	if diags.HasError() {
+		return diags
+	}
+
+	exec := newWaitForExecModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("cloud-init"),
+		types.StringValue("status"),
+		types.StringValue("--wait"),
+	}))
+
+	diags = exec.Execute(ctx, server, instanceName)
+	if diags.HasError() {
+		return diags
+	}
+
+	switch exec.ExitCode.ValueInt64() {
+	case 0:
+		return nil
+	case 2:
+		diags.AddWarning(
+			fmt.Sprintf("Cloud-init finished with recoverable errors on instance %q", instanceName),
+			strings.TrimSpace(exec.Output.ValueString()),
+		)
+
+		return diags
+	default:
+		diags.AddError(
+			fmt.Sprintf("Failed to wait for cloud-init on instance %q", instanceName),
+			fmt.Sprintf("Command \"cloud-init status --wait\" failed with exit code %d: %s", exec.ExitCode.ValueInt64(), execErrorOutput(exec)),
+		)
+
+		return diags
+	}
+}
+
+// waitForInstanceExec runs the wait_for command within the instance until it
+// exits successfully. The interval between attempts is doubled after each
+// failed attempt, up to one minute. If the number of retries is not set, the
+// command is retried until the context is cancelled.
+func waitForInstanceExec(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, waitFor WaitForModel) diag.Diagnostics {
+	interval := 5 * time.Second
+	if !waitFor.Interval.IsNull() {
+		var err error
+		interval, err = time.ParseDuration(waitFor.Interval.ValueString())
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError(fmt.Sprintf("Failed to parse interval duration for instance %q", instanceName), err.Error())
+			return diags
+		}
+	}
+
+	// Commands can be executed in virtual machines only once the
+	// LXD agent is running.
+	diags := waitForInstanceAgent(ctx, server, events, instanceName)
+	if diags.HasError() {
+		return diags
+	}
+
+	for attempt := int64(0); ; attempt++ {
+		exec := newWaitForExecModel(waitFor.Command)
+
+		diags = exec.Execute(ctx, server, instanceName)
+		if diags.HasError() {
+			return diags
+		}
+
+		exitCode := exec.ExitCode.ValueInt64()
+		if exitCode == 0 {
+			return nil
+		}
+
+		if !waitFor.Retries.IsNull() && attempt >= waitFor.Retries.ValueInt64() {
+			diags.AddError(
+				fmt.Sprintf("Failed to wait for command to succeed on instance %q", instanceName),
+				fmt.Sprintf("Command failed after %d attempts with exit code %d: %s", attempt+1, exitCode, execErrorOutput(exec)),
+			)
+
+			return diags
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			diags.AddError(
+				fmt.Sprintf("Failed to wait for command to succeed on instance %q", instanceName),
+				fmt.Sprintf("Context cancelled after %d attempts, last exit code %d: %s", attempt+1, exitCode, execErrorOutput(exec)),
+			)
+
+			return diags
+		}
+
+		interval = min(interval*2, time.Minute)
+	}
+}
+
+// newWaitForExecModel returns an exec model for the given command, that
+// records the command output and does not fail on a non-zero exit code.
+func newWaitForExecModel(command types.List) *common.ExecModel {
+	return &common.ExecModel{
+		Command:      command,
+		Environment:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		RecordOutput: types.BoolValue(true),
+		FailOnError:  types.BoolValue(false),
+		RunCount:     types.Int64Value(0),
+	}
+}
+
+// execErrorOutput returns the error output of the executed command, falling
+// back to its standard output if the error output is empty.
+func execErrorOutput(exec *common.ExecModel) string {
+	output := strings.TrimSpace(exec.Error.ValueString())
+	if output == "" {
+		output = strings.TrimSpace(exec.Output.ValueString())
+	}
+
+	return output
+}
+
 // waitForInstanceToBeReady waits for the instance to report a "Ready" status.
-func waitForInstanceToBeReady(ctx context.Context, server lxd.InstanceServer, instanceName string) diag.Diagnostics {
-	err := waitForInstanceCondition(ctx, server, instanceName, isInstanceReady)
+func waitForInstanceToBeReady(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string) diag.Diagnostics {
+	err := waitForInstanceCondition(ctx, server, events, instanceName, isInstanceReady)
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(fmt.Sprintf("Failed to wait for instance %q to be ready", instanceName), err.Error())
@@ -1763,16 +2470,65 @@ func waitForInstanceToBeReady(ctx context.Context, server lxd.InstanceServer, in
 
 // waitForState waits until the provided function reports one of the target
 // states. It returns either the resulting state or an error.
-func waitForState(ctx context.Context, refreshFunc retry.StateRefreshFunc, targets ...string) (any, error) {
-	stateRefreshConf := &retry.StateChangeConf{
-		Refresh:    refreshFunc,
-		Target:     targets,
-		Timeout:    3 * time.Minute,
-		MinTimeout: 2 * time.Second, // Timeout increases: 2, 4, 8, 10, 10, ...
-		Delay:      2 * time.Second, // Delay before the first check/refresh.
+//
+// The state is polled with an increasing interval. Additionally, the state
+// is refreshed right away whenever an event related to the instance is
+// received from the shared event stream of the remote. Polling is retained,
+// since not all state changes produce an event (e.g. network address
+// assignment).
+func waitForState(ctx context.Context, server lxd.InstanceServer, events *provider_config.InstanceEventListener, instanceName string, refreshFunc retry.StateRefreshFunc, targets ...string) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Minute)
+	defer cancel()
+
+	// Interval increases: 2, 4, 8, 10, 10, ...
+	delay := 2 * time.Second // Delay before the first check/refresh.
+	interval := 2 * time.Second
+	maxInterval := 10 * time.Second
+
+	var notify <-chan struct{}
+	if events != nil {
+		var unsubscribe func()
+
+		connInfo, err := server.GetConnectionInfo()
+		if err == nil {
+			notify, unsubscribe, err = events.Subscribe(connInfo.Project, instanceName)
+		}
+
+		if err == nil {
+			defer unsubscribe()
+		} else {
+			tflog.Debug(ctx, "Instance events not available, falling back to polling", map[string]any{
+				"instance": instanceName,
+				"error":    err.Error(),
+			})
+		}
 	}
 
-	return stateRefreshConf.WaitForStateContext(ctx)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Timeout while waiting for state to become %q: %w", strings.Join(targets, ", "), ctx.Err())
+		case <-notify:
+			// Refresh the state before the timer fires.
+			timer.Stop()
+		case <-timer.C:
+		}
+
+		result, state, err := refreshFunc()
+		if err != nil {
+			return result, err
+		}
+
+		if slices.Contains(targets, state) {
+			return result, nil
+		}
+
+		timer.Reset(interval)
+		interval = min(interval*2, maxInterval)
+	}
 }
 
 // isInstanceOperational determines if an instance is fully operational based
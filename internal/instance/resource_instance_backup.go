@@ -0,0 +1,539 @@
+package instance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// InstanceBackupModel represents the Terraform model for an LXD instance backup.
+type InstanceBackupModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Instance             types.String `tfsdk:"instance"`
+	InstanceOnly         types.Bool   `tfsdk:"instance_only"`
+	OptimizedStorage     types.Bool   `tfsdk:"optimized_storage"`
+	CompressionAlgorithm types.String `tfsdk:"compression_algorithm"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Path                 types.String `tfsdk:"path"`
+	Project              types.String `tfsdk:"project"`
+	Remote               types.String `tfsdk:"remote"`
+
+	// Computed.
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	Size      types.Int64  `tfsdk:"size"`
+	Checksum  types.String `tfsdk:"checksum"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceBackupResource represent LXD instance backup resource.
+type InstanceBackupResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewInstanceBackupResource returns a new instance backup resource.
+func NewInstanceBackupResource() resource.Resource {
+	return &InstanceBackupResource{}
+}
+
+func (r InstanceBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_backup"
+}
+
+func (r InstanceBackupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"instance": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"instance_only": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"optimized_storage": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"compression_algorithm": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"expires_at": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					common.ExpiryValidator{},
+				},
+			},
+
+			"path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"created_at": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"size": schema.Int64Attribute{
+				Computed: true,
+			},
+
+			"checksum": schema.StringAttribute{
+				Computed: true,
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *InstanceBackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *InstanceBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+		var plan InstanceBackupModel
+		var state InstanceBackupModel
+
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Size and checksum are only known once the backup is
+		// downloaded to a new path.
+		if plan.Path.Equal(state.Path) {
+			resp.Plan.SetAttribute(ctx, path.Root("size"), state.Size)
+			resp.Plan.SetAttribute(ctx, path.Root("checksum"), state.Checksum)
+		}
+	}
+
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
+func (r InstanceBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InstanceBackupModel
+
+	// Fetch resource model from Terraform plan.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := plan.Instance.ValueString()
+	backupName := plan.Name.ValueString()
+
+	expiresAt, err := common.ToExpiryTime(plan.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expiry", err.Error())
+		return
+	}
+
+	backupReq := api.InstanceBackupsPost{
+		Name:                 backupName,
+		ExpiresAt:            expiresAt,
+		InstanceOnly:         plan.InstanceOnly.ValueBool(),
+		OptimizedStorage:     plan.OptimizedStorage.ValueBool(),
+		CompressionAlgorithm: plan.CompressionAlgorithm.ValueString(),
+	}
+
+	op, err := server.CreateInstanceBackup(instanceName, backupReq)
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create backup %q for instance %q", backupName, instanceName), err.Error())
+		return
+	}
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Download backup if the target path is set.
+	plan.Size = types.Int64Null()
+	plan.Checksum = types.StringNull()
+	if plan.Path.ValueString() != "" {
+		diag := plan.download(ctx, server)
+		if diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r InstanceBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InstanceBackupModel
+
+	// Fetch resource model from Terraform state.
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r InstanceBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InstanceBackupModel
+	var state InstanceBackupModel
+
+	// Fetch resource model from Terraform plan.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := plan.Instance.ValueString()
+	backupName := plan.Name.ValueString()
+
+	// Update backup expiry if it has changed.
+	if !plan.ExpiresAt.Equal(state.ExpiresAt) {
+		_, etag, err := server.GetInstanceBackup(instanceName, backupName)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve backup %q for instance %q", backupName, instanceName), err.Error())
+			return
+		}
+
+		expiresAt, err := common.ToExpiryTime(plan.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expiry", err.Error())
+			return
+		}
+
+		backupPut := api.InstanceBackupPut{
+			ExpiresAt: expiresAt,
+		}
+
+		op, err := server.UpdateInstanceBackup(instanceName, backupName, backupPut, etag)
+		if err == nil {
+			err = op.WaitContext(ctx)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update backup %q for instance %q", backupName, instanceName), err.Error())
+			return
+		}
+	}
+
+	// Download backup again if the target path has changed.
+	plan.Size = state.Size
+	plan.Checksum = state.Checksum
+	if !plan.Path.Equal(state.Path) {
+		plan.Size = types.Int64Null()
+		plan.Checksum = types.StringNull()
+
+		if plan.Path.ValueString() != "" {
+			diag := plan.download(ctx, server)
+			if diag != nil {
+				resp.Diagnostics.Append(diag)
+				return
+			}
+		}
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r InstanceBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InstanceBackupModel
+
+	// Fetch resource model from Terraform state.
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	instanceName := state.Instance.ValueString()
+	backupName := state.Name.ValueString()
+	op, err := server.DeleteInstanceBackup(instanceName, backupName)
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove backup %q for instance %q", backupName, instanceName), err.Error())
+		return
+	}
+}
+
+// TaintState marks the state with identity fields required to target the instance backup.
+func (m InstanceBackupModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("instance"), m.Instance.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("project"), m.Project.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
+
+	return diags
+}
+
+// SyncState fetches the server's current state for an instance backup and
+// updates the provided model. It then applies this updated model as the new
+// state in Terraform.
+func (r InstanceBackupResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m InstanceBackupModel, forgetOnNotFound bool) diag.Diagnostics {
+	instanceName := m.Instance.ValueString()
+	backupName := m.Name.ValueString()
+	backup, _, err := server.GetInstanceBackup(instanceName, backupName)
+	if err != nil {
+		if forgetOnNotFound && errors.IsNotFoundError(err) {
+			tfState.RemoveResource(ctx)
+			return nil
+		}
+
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			fmt.Sprintf("Failed to sync state for backup %q for instance %q", backupName, instanceName),
+			err.Error(),
+		)}
+	}
+
+	// Keep the expiry in its configured format if it represents
+	// the same point in time as the one reported by the server.
+	expiresAt := common.FromExpiryTime(backup.ExpiresAt)
+	configured, err := common.ToExpiryTime(m.ExpiresAt.ValueString())
+	if err != nil || common.FromExpiryTime(configured) != expiresAt {
+		m.ExpiresAt = types.StringValue(expiresAt)
+	}
+
+	m.InstanceOnly = types.BoolValue(backup.InstanceOnly)
+	m.OptimizedStorage = types.BoolValue(backup.OptimizedStorage)
+	m.CreatedAt = types.Int64Value(backup.CreatedAt.Unix())
+
+	return tfState.Set(ctx, &m)
+}
+
+// download writes the instance backup into the file on the configured path
+// and updates the model with the size and checksum of the downloaded file.
+func (m *InstanceBackupModel) download(ctx context.Context, server lxd.InstanceServer) diag.Diagnostic {
+	instanceName := m.Instance.ValueString()
+	backupName := m.Name.ValueString()
+	targetPath := m.Path.ValueString()
+
+	size, checksum, err := downloadInstanceBackup(ctx, server, instanceName, backupName, targetPath)
+	if err != nil {
+		return diag.NewErrorDiagnostic(fmt.Sprintf("Failed to download backup %q for instance %q to %q", backupName, instanceName, targetPath), err.Error())
+	}
+
+	m.Size = types.Int64Value(size)
+	m.Checksum = types.StringValue(checksum)
+
+	return nil
+}
+
+// downloadInstanceBackup downloads the instance backup into the file on the
+// given path and returns its size and SHA-256 checksum. The download is
+// aborted once the context is done. The file is removed if the download fails.
+func downloadInstanceBackup(ctx context.Context, server lxd.InstanceServer, instanceName string, backupName string, targetPath string) (size int64, checksum string, err error) {
+	file, err := os.Create(targetPath)
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer func() {
+		_ = file.Close()
+
+		if err != nil {
+			_ = os.Remove(targetPath)
+		}
+	}()
+
+	backupReq := lxd.BackupFileRequest{
+		BackupFile: common.NewContextWriteSeeker(ctx, file),
+	}
+
+	backupResp, err := server.GetInstanceBackupFile(instanceName, backupName, &backupReq)
+	if err != nil {
+		return 0, "", err
+	}
+
+	// Calculate the checksum of the downloaded file.
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, "", err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return backupResp.Size, hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,133 @@
+package instance_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccInstanceBackup_basic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	backupName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceBackup_basic(instanceName, backupName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "name", backupName),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "instance", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "instance_only", "true"),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "optimized_storage", "false"),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "expires_at", ""),
+					resource.TestCheckResourceAttrSet("lxd_instance_backup.backup1", "created_at"),
+					resource.TestCheckNoResourceAttr("lxd_instance_backup.backup1", "path"),
+					resource.TestCheckNoResourceAttr("lxd_instance_backup.backup1", "size"),
+					resource.TestCheckNoResourceAttr("lxd_instance_backup.backup1", "checksum"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccInstanceBackup_expiry(instanceName, backupName, "2099-01-01T00:00:00Z"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_instance_backup.backup1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "name", backupName),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "expires_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstanceBackup_restore(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	restoredName := acctest.GenerateName(2, "-")
+	backupName := acctest.GenerateName(2, "-")
+	backupPath := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceBackup_basic(instanceName, backupName, backupPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "name", backupName),
+					resource.TestCheckResourceAttr("lxd_instance_backup.backup1", "path", backupPath),
+					resource.TestCheckResourceAttrSet("lxd_instance_backup.backup1", "size"),
+					resource.TestCheckResourceAttrSet("lxd_instance_backup.backup1", "checksum"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccInstanceBackup_restore(instanceName, backupName, backupPath, restoredName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.restored", "name", restoredName),
+					resource.TestCheckResourceAttr("lxd_instance.restored", "source_backup", backupPath),
+					resource.TestCheckResourceAttr("lxd_instance.restored", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.restored", "config.user.restored", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceBackup_basic(instanceName, backupName, backupPath string) string {
+	pathConfig := ""
+	if backupPath != "" {
+		pathConfig = fmt.Sprintf("path = %q", backupPath)
+	}
+
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+}
+
+resource "lxd_instance_backup" "backup1" {
+  name          = "%s"
+  instance      = lxd_instance.instance1.name
+  instance_only = true
+  %s
+}
+	`, instanceName, acctest.TestImage, backupName, pathConfig)
+}
+
+func testAccInstanceBackup_expiry(instanceName, backupName, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+}
+
+resource "lxd_instance_backup" "backup1" {
+  name          = "%s"
+  instance      = lxd_instance.instance1.name
+  instance_only = true
+  expires_at    = "%s"
+}
+	`, instanceName, acctest.TestImage, backupName, expiresAt)
+}
+
+func testAccInstanceBackup_restore(instanceName, backupName, backupPath, restoredName string) string {
+	return testAccInstanceBackup_basic(instanceName, backupName, backupPath) + fmt.Sprintf(`
+resource "lxd_instance" "restored" {
+  name          = "%s"
+  source_backup = lxd_instance_backup.backup1.path
+
+  config = {
+    "user.restored" = "true"
+  }
+}
+	`, restoredName)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	lxd "github.com/canonical/lxd/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -12,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -22,15 +22,21 @@ import (
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
 
+// privateKeyImported is a private state key that marks an imported device.
+// Imported devices may have been added manually, therefore they are not
+// required to be marked as managed by Terraform.
+const privateKeyImported = "imported"
+
 // InstanceDeviceModel represents a single device attached to an LXD instance.
 type InstanceDeviceModel struct {
-	Name         types.String `tfsdk:"name"`
-	InstanceName types.String `tfsdk:"instance_name"`
-	Project      types.String `tfsdk:"project"`
-	Remote       types.String `tfsdk:"remote"`
-	Target       types.String `tfsdk:"target"`
-	Type         types.String `tfsdk:"type"`
-	Properties   types.Map    `tfsdk:"properties"`
+	Name         types.String   `tfsdk:"name"`
+	InstanceName types.String   `tfsdk:"instance_name"`
+	Project      types.String   `tfsdk:"project"`
+	Remote       types.String   `tfsdk:"remote"`
+	Target       types.String   `tfsdk:"target"`
+	Type         types.String   `tfsdk:"type"`
+	Properties   types.Map      `tfsdk:"properties"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 // InstanceDeviceResource represents a device attachable to LXD instance.
@@ -77,7 +83,6 @@ func (r InstanceDeviceResource) Schema(ctx context.Context, _ resource.SchemaReq
 			"project": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString(provider_config.DefaultProject),
 				Description: "Project",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -123,6 +128,9 @@ func (r InstanceDeviceResource) Schema(ctx context.Context, _ resource.SchemaReq
 					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -141,11 +149,13 @@ func (r *InstanceDeviceResource) Configure(_ context.Context, req resource.Confi
 	r.provider = provider
 }
 
-func (r *InstanceDeviceResource) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+func (r *InstanceDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	resp.Diagnostics.AddWarning(
 		"lxd_instance_device is experimental",
 		"lxd_instance_device resource is an experimental feature of Terraform LXD Provider and it may change in the future.",
 	)
+
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
 }
 
 func (r InstanceDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,6 +167,15 @@ func (r InstanceDeviceResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	target := plan.Target.ValueString()
@@ -232,6 +251,15 @@ func (r InstanceDeviceResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	target := state.Target.ValueString()
@@ -254,6 +282,15 @@ func (r InstanceDeviceResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	target := plan.Target.ValueString()
@@ -269,6 +306,12 @@ func (r InstanceDeviceResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	imported, diags := req.Private.GetKey(ctx, privateKeyImported)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instance, etag, err := server.GetInstance(plan.InstanceName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing instance %q", plan.InstanceName.ValueString()), err.Error())
@@ -296,7 +339,7 @@ func (r InstanceDeviceResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	if oldDevice[common.UserManagedBy] != common.DeviceManagedByTerraform {
+	if oldDevice[common.UserManagedBy] != common.DeviceManagedByTerraform && imported == nil {
 		msg := fmt.Sprintf("Device %q on instance %q is not managed by Terraform", deviceName, instance.Name)
 		resp.Diagnostics.AddError("Cannot update non-managed device", msg)
 		return
@@ -317,6 +360,9 @@ func (r InstanceDeviceResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Device is now marked as managed by Terraform.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, nil)...)
+
 	// Sync state after successfully updating the device properties.
 	diags = r.SyncState(ctx, &resp.State, server, plan, false)
 	resp.Diagnostics.Append(diags...)
@@ -331,6 +377,15 @@ func (r InstanceDeviceResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	target := state.Target.ValueString()
@@ -343,6 +398,12 @@ func (r InstanceDeviceResource) Delete(ctx context.Context, req resource.DeleteR
 	instanceName := state.InstanceName.ValueString()
 	deviceName := state.Name.ValueString()
 
+	imported, diags := req.Private.GetKey(ctx, privateKeyImported)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instance, etag, err := server.GetInstance(instanceName)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing instance %q", state.InstanceName.ValueString()), err.Error())
@@ -355,7 +416,7 @@ func (r InstanceDeviceResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if oldDevice[common.UserManagedBy] != common.DeviceManagedByTerraform {
+	if oldDevice[common.UserManagedBy] != common.DeviceManagedByTerraform && imported == nil {
 		msg := fmt.Sprintf("Device %q on instance %q is not managed by Terraform", deviceName, instance.Name)
 		resp.Diagnostics.AddError("Cannot delete non-managed device", msg)
 		return
@@ -381,6 +442,31 @@ func (r InstanceDeviceResource) Delete(ctx context.Context, req resource.DeleteR
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r InstanceDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "instance_device",
+		RequiredFields: []string{"instance_name", "name"},
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
+	for k, v := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+
+	// Imported device may not be marked as managed by Terraform. Allow
+	// the device to be updated or removed regardless.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, []byte("true"))...)
+}
+
 // TaintState marks the state with identity fields required to target the device.
 func (m InstanceDeviceModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
 	var diags diag.Diagnostics
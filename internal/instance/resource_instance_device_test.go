@@ -33,6 +33,31 @@ func TestAccInstanceDevice_basic(t *testing.T) {
 	})
 }
 
+func TestAccInstanceDevice_importBasic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	deviceName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_instance_device.disk_attach"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceDevice_basic(instanceName, deviceName),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("%s/%s", instanceName, deviceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
 func TestAccInstanceDevice_volumeAttach(t *testing.T) {
 	instanceName := acctest.GenerateName(2, "-")
 	poolName := acctest.GenerateName(2, "-")
@@ -2,9 +2,14 @@ package instance
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mitchellh/go-homedir"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
@@ -34,14 +40,20 @@ type InstanceFileModel struct {
 	Remote     types.String `tfsdk:"remote"`
 
 	// common.InstanceFileModel
-	Content    types.String `tfsdk:"content"`
-	SourcePath types.String `tfsdk:"source_path"`
-	TargetPath types.String `tfsdk:"target_path"`
-	UserID     types.Int64  `tfsdk:"uid"`
-	GroupID    types.Int64  `tfsdk:"gid"`
-	Mode       types.String `tfsdk:"mode"`
-	CreateDirs types.Bool   `tfsdk:"create_directories"`
-	Append     types.Bool   `tfsdk:"append"`
+	Content    types.String   `tfsdk:"content"`
+	SourcePath types.String   `tfsdk:"source_path"`
+	TargetPath types.String   `tfsdk:"target_path"`
+	UserID     types.Int64    `tfsdk:"uid"`
+	GroupID    types.Int64    `tfsdk:"gid"`
+	Mode       types.String   `tfsdk:"mode"`
+	CreateDirs types.Bool     `tfsdk:"create_directories"`
+	Append     types.Bool     `tfsdk:"append"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+
+	// Write-only.
+	ContentWO        types.String `tfsdk:"content_wo"`
+	ContentWOVersion types.Int64  `tfsdk:"content_wo_version"`
+	ContentSHA256    types.String `tfsdk:"content_sha256"` // Computed.
 }
 
 // InstanceFileResource represent LXD instance file resource.
@@ -58,7 +70,7 @@ func (r InstanceFileResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_instance_file"
 }
 
-func (r InstanceFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r InstanceFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_id": schema.StringAttribute{
@@ -78,7 +90,6 @@ func (r InstanceFileResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -94,14 +105,37 @@ func (r InstanceFileResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"content": schema.StringAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessChecksumOnly(),
+				},
+			},
+
+			"content_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only content of the file. The value is never stored in the Terraform plan or state.",
+			},
+
+			"content_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the write-only content. Changing the version forces the file to be replaced.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 checksum of the file content. Set when the content is not stored, that is when write-only content is used or the file was imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 
 			"source_path": schema.StringAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessChecksumOnly(),
 				},
 				Validators: []validator.String{
 					// Specify all attributes at one field to
@@ -109,6 +143,7 @@ func (r InstanceFileResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringvalidator.ExactlyOneOf(
 						path.MatchRoot("source_path"),
 						path.MatchRoot("content"),
+						path.MatchRoot("content_wo"),
 					),
 				},
 			},
@@ -150,16 +185,19 @@ func (r InstanceFileResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"create_directories": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessUnset(),
 				},
 			},
 
 			"append": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessUnset(),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -179,6 +217,65 @@ func (r *InstanceFileResource) Configure(_ context.Context, req resource.Configu
 	r.provider = provider
 }
 
+func (r *InstanceFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+
+	// Ignore plan modification if plan is null (on destroy).
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var contentWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_wo"), &contentWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the content is not stored (write-only content or imported file),
+	// its checksum is compared with the checksum of the existing file to
+	// detect changes.
+	var stateChecksum types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_sha256"), &stateChecksum)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if contentWO.IsNull() {
+		resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringNull())
+
+		// The configured content or source file is adopted in place
+		// if it matches the existing file.
+		if !stateChecksum.IsNull() {
+			var plan InstanceFileModel
+			resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			checksum := plannedContentChecksum(plan)
+			if checksum != stateChecksum.ValueString() {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+			}
+		}
+
+		return
+	}
+
+	// Unknown write-only content is applied only when the version changes.
+	if contentWO.IsUnknown() {
+		return
+	}
+
+	checksum := contentChecksum([]byte(contentWO.ValueString()))
+	if !stateChecksum.IsNull() && stateChecksum.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+
+	resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), checksum)
+}
+
 func (r InstanceFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan InstanceFileModel
 
@@ -189,6 +286,15 @@ func (r InstanceFileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -205,8 +311,21 @@ func (r InstanceFileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Write-only content is available only in the configuration.
+	var contentWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_wo"), &contentWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := plan.Content
+	if !contentWO.IsNull() {
+		content = contentWO
+		plan.ContentSHA256 = types.StringValue(contentChecksum([]byte(contentWO.ValueString())))
+	}
+
 	file := common.InstanceFileModel{
-		Content:    plan.Content,
+		Content:    content,
 		SourcePath: plan.SourcePath,
 		TargetPath: plan.TargetPath,
 		UserID:     plan.UserID,
@@ -242,6 +361,15 @@ func (r InstanceFileResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote, instanceName, targetPath := splitFileResourceID(state.ResourceID.ValueString())
 
 	project := state.Project.ValueString()
@@ -266,7 +394,7 @@ func (r InstanceFileResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Fetch an existing file.
-	_, file, err := server.GetInstanceFile(instanceName, targetPath)
+	reader, file, err := server.GetInstanceFile(instanceName, targetPath)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			// If file is not found, remove it from the Terraform state
@@ -279,6 +407,25 @@ func (r InstanceFileResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if reader != nil {
+		defer reader.Close()
+	}
+
+	// Neither content nor source path is known when the file is
+	// imported or write-only content is used. In such case, only the
+	// checksum of the file is tracked, as the content may be binary
+	// or large. Appended content cannot be compared with the file.
+	if state.Content.IsNull() && state.SourcePath.IsNull() && !state.Append.ValueBool() && reader != nil {
+		hash := sha256.New()
+		_, err := io.Copy(hash, reader)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to read file %q from instance %q", targetPath, instanceName), err.Error())
+			return
+		}
+
+		state.ContentSHA256 = types.StringValue(hex.EncodeToString(hash.Sum(nil)))
+	}
+
 	state.Instance = types.StringValue(instanceName)
 	state.TargetPath = types.StringValue(targetPath)
 	state.UserID = types.Int64Value(file.UID)
@@ -290,7 +437,22 @@ func (r InstanceFileResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(diags...)
 }
 
+// Update only stores the planned values. Changes of the file are applied
+// by replacing it, except when the configured content of an imported file
+// matches the existing file.
 func (r InstanceFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InstanceFileModel
+
+	// Fetch resource model from Terraform plan.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update Terraform state.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r InstanceFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -303,6 +465,15 @@ func (r InstanceFileResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote, instanceName, targetFile := splitFileResourceID(state.ResourceID.ValueString())
 
 	project := state.Project.ValueString()
@@ -331,6 +502,96 @@ func (r InstanceFileResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r InstanceFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "instance_file",
+		RequiredFields: []string{"instance", "target_path"},
+		TrailingPath:   true,
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
+	fields["resource_id"] = createFileResourceID(fields["remote"], fields["instance"], fields["target_path"])
+
+	for k, v := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
+// requiresReplaceUnlessUnset returns a plan modifier that requires the file
+// to be replaced when the value changes, unless the value was not previously
+// set. This allows imported files to adopt the configured value in place.
+func requiresReplaceUnlessUnset() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"File is replaced when the value changes, unless the value was not previously set.",
+		"File is replaced when the value changes, unless the value was not previously set.",
+	)
+}
+
+// requiresReplaceUnlessChecksumOnly returns a plan modifier that requires
+// the file to be replaced when the value changes, unless the value was not
+// previously set and only the checksum of the file is known. In such case,
+// the file is replaced only if its checksum differs from the new content,
+// which is handled in ModifyPlan.
+func requiresReplaceUnlessChecksumOnly() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if !req.StateValue.IsNull() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			var stateChecksum types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_sha256"), &stateChecksum)...)
+			resp.RequiresReplace = stateChecksum.IsNull()
+		},
+		"File is replaced when the value changes, unless only the checksum of the file was previously known.",
+		"File is replaced when the value changes, unless only the checksum of the file was previously known.",
+	)
+}
+
+// plannedContentChecksum returns the checksum of the planned content or
+// source file. An empty string is returned if the checksum cannot be
+// determined, for example when the source file does not exist yet.
+func plannedContentChecksum(plan InstanceFileModel) string {
+	if !plan.Content.IsNull() && !plan.Content.IsUnknown() {
+		return contentChecksum([]byte(plan.Content.ValueString()))
+	}
+
+	if plan.SourcePath.IsNull() || plan.SourcePath.IsUnknown() {
+		return ""
+	}
+
+	sourcePath, err := homedir.Expand(plan.SourcePath.ValueString())
+	if err != nil {
+		return ""
+	}
+
+	content, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return ""
+	}
+
+	return contentChecksum(content)
+}
+
+// contentChecksum returns the hex encoded SHA-256 checksum of the content.
+func contentChecksum(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:])
+}
+
 // createFileResourceID creates new file ID by concatenating remote,
 // instnaceName, and targetPath using colon.
 func createFileResourceID(remote string, instanceName string, targetPath string) string {
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 )
 
@@ -56,6 +59,47 @@ func TestAccInstanceFile_basic(t *testing.T) {
 	})
 }
 
+func TestAccInstanceFile_contentWriteOnly(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceFile_contentWriteOnly(instanceName, "secret-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "target_path", "/foo/secret.txt"),
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content_wo_version", "1"),
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content_sha256", "f7e7c36e458e80e6b6a2c67d0a9ec09bd718dadd7bfa8d6bf6e7ad526e46c2f7"),
+					// Ensure content is not stored.
+					resource.TestCheckNoResourceAttr("lxd_instance_file.file1", "content"),
+					resource.TestCheckNoResourceAttr("lxd_instance_file.file1", "content_wo"),
+				),
+			},
+			{
+				// Changed content is detected by its checksum.
+				Config: acctest.Provider() + testAccInstanceFile_contentWriteOnly(instanceName, "secret-2", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content_sha256", "f4b6bb6548129dacf11c1a9c4dffffefd4aa6b21fcf4e9754cc03b731cbe7c25"),
+					resource.TestCheckNoResourceAttr("lxd_instance_file.file1", "content"),
+				),
+			},
+			{
+				// Version change forces the file to be replaced.
+				Config: acctest.Provider() + testAccInstanceFile_contentWriteOnly(instanceName, "secret-2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("lxd_instance_file.file1", "content"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccInstanceFile_project(t *testing.T) {
 	projectName := acctest.GenerateName(2, "-")
 	instanceName := acctest.GenerateName(2, "-")
@@ -83,6 +127,97 @@ func TestAccInstanceFile_project(t *testing.T) {
 	})
 }
 
+func TestAccInstanceFile_importBasic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_instance_file.file1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceFile_content(instanceName),
+			},
+			{
+				Config:                               acctest.Provider() + testAccInstanceFile_content(instanceName),
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("/%s/foo/bar.txt", instanceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+				// Only the checksum of an imported file is tracked.
+				ImportStateVerifyIgnore: []string{"create_directories", "content", "content_sha256"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+
+					state := states[0]
+					if state.Attributes["content"] != "" {
+						return fmt.Errorf("expected content not to be set")
+					}
+
+					checksum := "c98c24b677eff44860afea6f493bbaec5bb1c4cbb209c6fc2bbb47f66ff2ad31"
+					if state.Attributes["content_sha256"] != checksum {
+						return fmt.Errorf("expected content_sha256 %q, got %q", checksum, state.Attributes["content_sha256"])
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccInstanceFile_importAdoptContent(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceFile_content(instanceName),
+			},
+			{
+				// Forget the file, but keep it within the instance.
+				Config: acctest.Provider() + testAccInstanceFile_forget(instanceName),
+			},
+			{
+				// Matching content adopts the imported file in place.
+				Config: acctest.Provider() + testAccInstanceFile_import(instanceName, "Hello, World!\n"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_instance_file.file1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content", "Hello, World!\n"),
+					resource.TestCheckNoResourceAttr("lxd_instance_file.file1", "content_sha256"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccInstanceFile_forget(instanceName),
+			},
+			{
+				// Different content replaces the imported file.
+				Config: acctest.Provider() + testAccInstanceFile_import(instanceName, "Goodbye, World!\n"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_instance_file.file1", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance_file.file1", "content", "Goodbye, World!\n"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceFile_content(name string) string {
 	return fmt.Sprintf(`
 resource "lxd_instance" "instance1" {
@@ -99,6 +234,61 @@ resource "lxd_instance_file" "file1" {
 	`, name, acctest.TestImage)
 }
 
+func testAccInstanceFile_forget(name string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = "%s"
+  image = "%s"
+}
+
+removed {
+  from = lxd_instance_file.file1
+
+  lifecycle {
+    destroy = false
+  }
+}
+	`, name, acctest.TestImage)
+}
+
+func testAccInstanceFile_import(name string, content string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = "%s"
+  image = "%s"
+}
+
+import {
+  to = lxd_instance_file.file1
+  id = "/%s/foo/bar.txt"
+}
+
+resource "lxd_instance_file" "file1" {
+  instance    = lxd_instance.instance1.name
+  content     = %q
+  target_path = "/foo/bar.txt"
+}
+	`, name, acctest.TestImage, name, content)
+}
+
+func testAccInstanceFile_contentWriteOnly(name string, content string, version int) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = "%s"
+  image = "%s"
+}
+
+resource "lxd_instance_file" "file1" {
+  instance           = lxd_instance.instance1.name
+  content_wo         = %q
+  content_wo_version = %d
+  target_path        = "/foo/secret.txt"
+  mode               = "0600"
+  create_directories = true
+}
+	`, name, acctest.TestImage, content, version)
+}
+
 func testAccInstanceFile_sourcePath(name string) string {
 	return fmt.Sprintf(`
 resource "lxd_instance" "instance1" {
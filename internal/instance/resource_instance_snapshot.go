@@ -9,6 +9,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,11 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
@@ -35,6 +36,9 @@ type InstanceSnapshotModel struct {
 
 	// Computed.
 	CreatedAt types.Int64 `tfsdk:"created_at"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // InstanceSnapshotResource represent LXD instance snapshot resource.
@@ -51,7 +55,7 @@ func (r InstanceSnapshotResource) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = fmt.Sprintf("%s_instance_snapshot", req.ProviderTypeName)
 }
 
-func (r InstanceSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r InstanceSnapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -80,7 +84,6 @@ func (r InstanceSnapshotResource) Schema(_ context.Context, _ resource.SchemaReq
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -98,6 +101,9 @@ func (r InstanceSnapshotResource) Schema(_ context.Context, _ resource.SchemaReq
 			"created_at": schema.Int64Attribute{
 				Computed: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -117,6 +123,10 @@ func (r *InstanceSnapshotResource) Configure(_ context.Context, req resource.Con
 	r.provider = provider
 }
 
+func (r *InstanceSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r InstanceSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan InstanceSnapshotModel
 
@@ -127,6 +137,15 @@ func (r InstanceSnapshotResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -152,7 +171,7 @@ func (r InstanceSnapshotResource) Create(ctx context.Context, req resource.Creat
 		}
 
 		// Wait for snapshot operation to complete.
-		serr = op.Wait()
+		serr = op.WaitContext(ctx)
 		if serr == nil {
 			break
 		}
@@ -194,6 +213,15 @@ func (r InstanceSnapshotResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -220,6 +248,15 @@ func (r InstanceSnapshotResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -241,6 +278,27 @@ func (r InstanceSnapshotResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r InstanceSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "instance_snapshot",
+		RequiredFields: []string{"instance", "name"},
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
+	for k, v := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
 // TaintState marks the state with identity fields required to target the instance snapshot.
 func (m InstanceSnapshotModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
 	var diags diag.Diagnostics
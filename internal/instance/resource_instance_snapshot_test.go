@@ -117,6 +117,53 @@ func TestAccInstanceSnapshot_project(t *testing.T) {
 	})
 }
 
+func TestAccInstanceSnapshot_importBasic(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_instance_snapshot.snapshot1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceSnapshot_basic(instanceName, snapshotName, false),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("%s/%s", instanceName, snapshotName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccInstanceSnapshot_importProject(t *testing.T) {
+	projectName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_instance_snapshot.snapshot1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstanceSnapshot_project(projectName, instanceName, snapshotName),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("%s/%s/%s", projectName, instanceName, snapshotName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
 func TestAccInstanceSnapshot_missingInstance(t *testing.T) {
 	instanceName := acctest.GenerateName(2, "-")
 	snapshotName := acctest.GenerateName(2, "-")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 	config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
@@ -721,6 +722,67 @@ func TestAccInstance_fileUploadContent(t *testing.T) {
 	})
 }
 
+func TestAccInstance_fileUploadContentWriteOnly(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_fileUploadContentWriteOnly(instanceName, "secret-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file.#", "1"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file.0.target_path", "/foo/secret.txt"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_wo_version", "1"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_sha256./foo/secret.txt", "f7e7c36e458e80e6b6a2c67d0a9ec09bd718dadd7bfa8d6bf6e7ad526e46c2f7"),
+					// Ensure content is not stored.
+					resource.TestCheckNoResourceAttr("lxd_instance.instance1", "file.0.content"),
+					resource.TestCheckNoResourceAttr("lxd_instance.instance1", "file_content_wo"),
+				),
+			},
+			{
+				// Content change is detected by its checksum.
+				Config: acctest.Provider() + testAccInstance_fileUploadContentWriteOnly(instanceName, "secret-2", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_instance.instance1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_wo_version", "1"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_sha256./foo/secret.txt", "f4b6bb6548129dacf11c1a9c4dffffefd4aa6b21fcf4e9754cc03b731cbe7c25"),
+				),
+			},
+			{
+				// Version change re-uploads the files.
+				Config: acctest.Provider() + testAccInstance_fileUploadContentWriteOnly(instanceName, "secret-2", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_instance.instance1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_wo_version", "2"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "file_content_sha256./foo/secret.txt", "f4b6bb6548129dacf11c1a9c4dffffefd4aa6b21fcf4e9754cc03b731cbe7c25"),
+					resource.TestCheckNoResourceAttr("lxd_instance.instance1", "file.0.content"),
+				),
+			},
+			{
+				Config:      acctest.Provider() + testAccInstance_fileUploadContentWriteOnlyInvalid(instanceName),
+				ExpectError: regexp.MustCompile(`Write-only content is set for file "/foo/missing.txt"`),
+			},
+		},
+	})
+}
+
 func TestAccInstance_execOutput(t *testing.T) {
 	instanceName := acctest.GenerateName(2, "-")
 
@@ -1676,6 +1738,196 @@ func TestAccInstance_waitForIPv4AndIPv6(t *testing.T) {
 	})
 }
 
+func TestAccInstance_waitForCloudInit(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_waitForCloudInit(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.#", "1"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.type", "cloud_init"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "execs.check.exit_code", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_waitForPort(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_waitForPort(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.type", "port"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.port", "8080"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.nic", "eth0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_waitForExec(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The command succeeds on the third attempt.
+				Config: acctest.Provider() + testAccInstance_waitForExec(instanceName, `["/bin/sh", "-c", "echo >> /root/attempts && test $(wc -l < /root/attempts) -ge 3"]`, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.type", "exec"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.command.#", "3"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.interval", "1s"),
+					resource.TestCheckResourceAttr("lxd_instance.instance1", "wait_for.0.retries", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_waitForExecFailure(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForExec(instanceName, `["test", "-f", "/root/missing"]`, 2),
+				ExpectError: regexp.MustCompile(`Command failed after 3 attempts with exit code 1`),
+			},
+		},
+	})
+}
+
+func TestAccInstance_waitForInvalid(t *testing.T) {
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForInvalid(instanceName, `type = "port"`),
+				ExpectError: regexp.MustCompile(`The "port" attribute is required when wait_for type is "port"`),
+			},
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForInvalid(instanceName, `type = "exec"`),
+				ExpectError: regexp.MustCompile(`The "command" attribute is required when wait_for type is "exec"`),
+			},
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForInvalid(instanceName, "type = \"exec\"\ncommand = [\"true\"]\ninterval = \"soon\""),
+				ExpectError: regexp.MustCompile(`Invalid wait_for interval duration "soon"`),
+			},
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForInvalid(instanceName, "type = \"cloud_init\"\nretries = 3"),
+				ExpectError: regexp.MustCompile(`can only be set when wait_for type is "exec"`),
+			},
+			{
+				Config:      acctest.Provider() + testAccInstance_waitForInvalid(instanceName, "type = \"ready\"\nnic = \"eth0\""),
+				ExpectError: regexp.MustCompile(`The "nic" can only be set when wait_for type is "ipv4", "ipv6", or "port"`),
+			},
+		},
+	})
+}
+
+func TestAccInstance_sourceInstance(t *testing.T) {
+	sourceName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_sourceInstance(sourceName, instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.name", sourceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.instance_only", "false"),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "config.user.cloned", "true"),
+					resource.TestCheckNoResourceAttr("lxd_instance.instance2", "config.user.golden"),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccInstance_sourceInstance(sourceName, instanceName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Copy arguments do not change the source identity.
+						plancheck.ExpectResourceAction("lxd_instance.instance2", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.instance_only", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_sourceInstanceSnapshot(t *testing.T) {
+	sourceName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_sourceInstanceSnapshot(sourceName, snapshotName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "status", "Running"),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.name", sourceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.snapshot", snapshotName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_sourceInstanceProject(t *testing.T) {
+	projectName := acctest.GenerateName(2, "")
+	sourceName := acctest.GenerateName(2, "-")
+	instanceName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccInstance_sourceInstanceProject(projectName, sourceName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "name", instanceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "project", projectName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.name", sourceName),
+					resource.TestCheckResourceAttr("lxd_instance.instance2", "source_instance.project", "default"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstance_basic(name string) string {
 	return fmt.Sprintf(`
 resource "lxd_instance" "instance1" {
@@ -2047,6 +2299,46 @@ resource "lxd_instance" "instance1" {
 	`, name, acctest.TestImage)
 }
 
+func testAccInstance_fileUploadContentWriteOnly(name string, content string, version int) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = "%s"
+  image = "%s"
+
+  file {
+    target_path        = "/foo/secret.txt"
+    mode               = "0600"
+    create_directories = true
+  }
+
+  file_content_wo = {
+    "/foo/secret.txt" = %q
+  }
+
+  file_content_wo_version = %d
+}
+	`, name, acctest.TestImage, content, version)
+}
+
+func testAccInstance_fileUploadContentWriteOnlyInvalid(name string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = "%s"
+  image = "%s"
+
+  file {
+    target_path        = "/foo/secret.txt"
+    mode               = "0600"
+    create_directories = true
+  }
+
+  file_content_wo = {
+    "/foo/missing.txt" = "secret"
+  }
+}
+	`, name, acctest.TestImage)
+}
+
 func testAccInstance_fileUploadContent_2(name string) string {
 	return fmt.Sprintf(`
 resource "lxd_instance" "instance1" {
@@ -2808,3 +3100,162 @@ resource "lxd_instance" "instance1" {
 }
 	`, networkName, subnet.GatewayCIDRv4(), subnet.GatewayCIDRv6(), instanceName, acctest.TestImage, subnet.HostIPv4(200))
 }
+
+func testAccInstance_waitForCloudInit(instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = "images:alpine/edge/cloud"
+
+  config = {
+    "cloud-init.user-data" = <<-EOT
+      #cloud-config
+      runcmd:
+        - touch /root/cloud-init-done
+    EOT
+  }
+
+  wait_for {
+    type = "cloud_init"
+  }
+
+  execs = {
+    "check" = {
+      command       = ["test", "-f", "/root/cloud-init-done"]
+      fail_on_error = true
+    }
+  }
+}
+	`, instanceName)
+}
+
+func testAccInstance_waitForPort(instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = "images:alpine/edge/cloud"
+
+  config = {
+    "cloud-init.user-data" = <<-EOT
+      #cloud-config
+      runcmd:
+        - [sh, -c, "nc -lk -p 8080 -e true &"]
+    EOT
+  }
+
+  wait_for {
+    type = "port"
+    port = 8080
+    nic  = "eth0"
+  }
+}
+	`, instanceName)
+}
+
+func testAccInstance_waitForExec(instanceName string, command string, retries int) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+
+  wait_for {
+    type     = "exec"
+    command  = %[3]s
+    interval = "1s"
+    retries  = %[4]d
+  }
+}
+	`, instanceName, acctest.TestImage, command, retries)
+}
+
+func testAccInstance_waitForInvalid(instanceName string, waitFor string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name  = %[1]q
+  image = %[2]q
+
+  wait_for {
+    %[3]s
+  }
+}
+	`, instanceName, acctest.TestImage, waitFor)
+}
+
+func testAccInstance_sourceInstance(sourceName string, instanceName string, instanceOnly bool) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+
+  config = {
+    "user.golden" = "true"
+  }
+}
+
+resource "lxd_instance" "instance2" {
+  name = "%s"
+
+  source_instance = {
+    name          = lxd_instance.instance1.name
+    instance_only = %v
+  }
+
+  config = {
+    "user.cloned" = "true"
+  }
+}
+	`, sourceName, acctest.TestImage, instanceName, instanceOnly)
+}
+
+func testAccInstance_sourceInstanceSnapshot(sourceName string, snapshotName string, instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+}
+
+resource "lxd_instance_snapshot" "snapshot1" {
+  name     = "%s"
+  instance = lxd_instance.instance1.name
+}
+
+resource "lxd_instance" "instance2" {
+  name = "%s"
+
+  source_instance = {
+    name     = lxd_instance.instance1.name
+    snapshot = lxd_instance_snapshot.snapshot1.name
+  }
+}
+	`, sourceName, acctest.TestImage, snapshotName, instanceName)
+}
+
+func testAccInstance_sourceInstanceProject(projectName string, sourceName string, instanceName string) string {
+	return fmt.Sprintf(`
+resource "lxd_project" "project1" {
+  name = "%s"
+  config = {
+    "features.images"   = false
+    "features.profiles" = false
+  }
+}
+
+resource "lxd_instance" "instance1" {
+  name    = "%s"
+  image   = "%s"
+  running = false
+}
+
+resource "lxd_instance" "instance2" {
+  name    = "%s"
+  project = lxd_project.project1.name
+
+  source_instance = {
+    name    = lxd_instance.instance1.name
+    project = "default"
+  }
+}
+	`, projectName, sourceName, acctest.TestImage, instanceName)
+}
@@ -0,0 +1,201 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// NetworksDataSourceModel resource data model that matches the schema.
+type NetworksDataSourceModel struct {
+	Filter      types.String `tfsdk:"filter"`
+	Project     types.String `tfsdk:"project"`
+	AllProjects types.Bool   `tfsdk:"all_projects"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Computed.
+	Networks []NetworksItemModel `tfsdk:"networks"`
+}
+
+// NetworksItemModel represents a single network in the list.
+type NetworksItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Project     types.String `tfsdk:"project"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Managed     types.Bool   `tfsdk:"managed"`
+	Status      types.String `tfsdk:"status"`
+	Config      types.Map    `tfsdk:"config"`
+}
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &NetworksDataSource{}
+}
+
+type NetworksDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func (d *NetworksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_networks", req.ProviderTypeName)
+}
+
+func (d *NetworksDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Description: "LXD API filter expression, for example \"type eq ovn\"",
+				Optional:    true,
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("all_projects")),
+				},
+			},
+
+			"all_projects": schema.BoolAttribute{
+				Description: "List networks from all projects",
+				Optional:    true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"networks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of networks matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"project": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"managed": schema.BoolAttribute{
+							Computed: true,
+						},
+
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworksDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := d.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if project == "" {
+		project = d.provider.Project(remote)
+	}
+
+	var networks []api.Network
+	if state.AllProjects.ValueBool() {
+		networks, err = server.GetNetworksAllProjects()
+	} else {
+		networks, err = server.GetNetworks()
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve networks", err.Error())
+		return
+	}
+
+	// Networks endpoint does not support server side filtering.
+	networks, err = common.FilterObjects(networks, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Failed to filter networks", err.Error())
+		return
+	}
+
+	state.Networks = make([]NetworksItemModel, 0, len(networks))
+	for _, network := range networks {
+		config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(network.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		networkProject := network.Project
+		if networkProject == "" {
+			networkProject = project
+		}
+
+		state.Networks = append(state.Networks, NetworksItemModel{
+			Name:        types.StringValue(network.Name),
+			Project:     types.StringValue(networkProject),
+			Description: types.StringValue(network.Description),
+			Type:        types.StringValue(network.Type),
+			Managed:     types.BoolValue(network.Managed),
+			Status:      types.StringValue(network.Status),
+			Config:      config,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,50 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccNetworks_DS_filter(t *testing.T) {
+	networkName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworks_DS_filter(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.0.name", networkName),
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.0.project", "default"),
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.0.type", "bridge"),
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.0.managed", "true"),
+					resource.TestCheckResourceAttr("data.lxd_networks.networks", "networks.0.config.ipv4.nat", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworks_DS_filter(networkName string) string {
+	return fmt.Sprintf(`
+resource "lxd_network" "network" {
+  name = %[1]q
+
+  config = {
+    "ipv4.nat" = false
+    "ipv6.nat" = false
+  }
+}
+
+data "lxd_networks" "networks" {
+  filter = "name eq %[1]s and managed eq true"
+
+  depends_on = [lxd_network.network]
+}
+`, networkName)
+}
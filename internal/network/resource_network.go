@@ -9,6 +9,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -42,6 +43,9 @@ type NetworkModel struct {
 	Managed types.Bool   `tfsdk:"managed"`
 	IPv4    types.String `tfsdk:"ipv4_address"`
 	IPv6    types.String `tfsdk:"ipv6_address"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NetworkMemberModel represents a per-member network configuration override.
@@ -65,7 +69,7 @@ func (r NetworkResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema for network resource.
-func (r NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r NetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -96,7 +100,6 @@ func (r NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -157,6 +160,9 @@ func (r NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"ipv6_address": schema.StringAttribute{
 				Computed: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -182,6 +188,8 @@ func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+
 	var plan NetworkModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -243,6 +251,15 @@ func (r NetworkResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -338,6 +355,15 @@ func (r NetworkResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -358,6 +384,15 @@ func (r NetworkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -435,7 +470,7 @@ func (r NetworkResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update Terraform state.
-	diags := r.SyncState(ctx, &resp.State, server, plan, false)
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -448,6 +483,15 @@ func (r NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -486,7 +530,7 @@ func (r NetworkResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	if fields["project"] == "" {
-		fields["project"] = provider_config.DefaultProject
+		fields["project"] = r.provider.Project(fields["remote"])
 	}
 
 	for k, v := range fields {
@@ -3,9 +3,12 @@ package network
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,13 +30,14 @@ import (
 
 // NetworkAclModel resource data model that matches the schema.
 type NetworkAclModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Project     types.String `tfsdk:"project"`
-	Remote      types.String `tfsdk:"remote"`
-	Config      types.Map    `tfsdk:"config"`
-	Egress      types.Set    `tfsdk:"egress"`
-	Ingress     types.Set    `tfsdk:"ingress"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Project     types.String   `tfsdk:"project"`
+	Remote      types.String   `tfsdk:"remote"`
+	Config      types.Map      `tfsdk:"config"`
+	Egress      types.Set      `tfsdk:"egress"`
+	Ingress     types.Set      `tfsdk:"ingress"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NetworkAclRuleModel resource data model that matches the schema.
@@ -62,7 +66,7 @@ func (r *NetworkAclResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_network_acl"
 }
 
-func (r *NetworkAclResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworkAclResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	aclRuleObjectType := aclRuleObjectType()
 
 	resp.Schema = schema.Schema{
@@ -80,7 +84,6 @@ func (r *NetworkAclResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -117,6 +120,9 @@ func (r *NetworkAclResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					Attributes: aclRuleAttributes(),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -205,6 +211,78 @@ func (r *NetworkAclResource) Configure(_ context.Context, req resource.Configure
 	r.provider = provider
 }
 
+func (r *NetworkAclResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.provider == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan NetworkAclModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cannot verify address set references if the target is not yet known.
+	if plan.Project.IsUnknown() || plan.Remote.IsUnknown() {
+		return
+	}
+
+	egressSets, diags := aclAddressSetReferences(ctx, plan.Egress)
+	resp.Diagnostics.Append(diags...)
+
+	ingressSets, diags := aclAddressSetReferences(ctx, plan.Ingress)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setNames := append(egressSets, ingressSets...)
+	slices.Sort(setNames)
+	setNames = slices.Compact(setNames)
+	if len(setNames) == 0 {
+		return
+	}
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	err = server.CheckExtension("network_address_set")
+	if err != nil {
+		resp.Diagnostics.AddError("Network address sets are not supported by the LXD server", err.Error())
+		return
+	}
+
+	for _, setName := range setNames {
+		_, _, err := server.GetNetworkAddressSet(setName)
+		if err == nil {
+			continue
+		}
+
+		// References to the address sets created within the same apply
+		// are unknown during the plan, and therefore not checked.
+		if errors.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Network address set %q not found", setName),
+				fmt.Sprintf("Network ACL %q references network address set %q that does not exist in project %q. To reference an address set created within the same apply, use the %q attribute of the %q resource.", plan.Name.ValueString(), setName, project, "reference", "lxd_network_address_set"),
+			)
+
+			continue
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve network address set %q", setName), err.Error())
+		return
+	}
+}
+
 func (r *NetworkAclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkAclModel
 
@@ -214,6 +292,15 @@ func (r *NetworkAclResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -277,6 +364,15 @@ func (r *NetworkAclResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -298,6 +394,15 @@ func (r *NetworkAclResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -356,6 +461,15 @@ func (r *NetworkAclResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -388,6 +502,10 @@ func (r *NetworkAclResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
 	for k, v := range fields {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
 	}
@@ -442,6 +560,47 @@ func (r *NetworkAclResource) SyncState(ctx context.Context, tfState *tfsdk.State
 	return tfState.Set(ctx, &m)
 }
 
+// aclAddressSetReferences returns names of the network address sets that are
+// referenced as "$name" in the source or destination of the given ACL rules.
+func aclAddressSetReferences(ctx context.Context, aclRuleList types.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if aclRuleList.IsNull() || aclRuleList.IsUnknown() {
+		return nil, nil
+	}
+
+	aclRuleModelList := make([]NetworkAclRuleModel, 0, len(aclRuleList.Elements()))
+	diags.Append(aclRuleList.ElementsAs(ctx, &aclRuleModelList, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var setNames []string
+	for _, aclRule := range aclRuleModelList {
+		for _, subjects := range []types.String{aclRule.Source, aclRule.Destination} {
+			if subjects.IsUnknown() || subjects.IsNull() {
+				continue
+			}
+
+			for subject := range strings.SplitSeq(subjects.ValueString(), ",") {
+				setName, ok := strings.CutPrefix(strings.TrimSpace(subject), "$")
+				if !ok {
+					continue
+				}
+
+				if setName == "" {
+					diags.AddError("Invalid network ACL rule", fmt.Sprintf("Network address set reference %q must contain an address set name", subject))
+					continue
+				}
+
+				setNames = append(setNames, setName)
+			}
+		}
+	}
+
+	return setNames, diags
+}
+
 // ToNetworkAclRules converts ACL rules from type types.Set into []api.NetworkACLRule.
 func ToNetworkAclRules(ctx context.Context, aclRuleList types.Set) ([]api.NetworkACLRule, diag.Diagnostics) {
 	if aclRuleList.IsNull() {
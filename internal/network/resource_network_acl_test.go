@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 )
 
@@ -140,6 +141,37 @@ func TestAccNetworkACL_egressAndIngress(t *testing.T) {
 	})
 }
 
+func TestAccNetworkACL_timeouts(t *testing.T) {
+	aclName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkACL_withTimeouts(aclName, "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_acl.acl", "name", aclName),
+					resource.TestCheckResourceAttr("lxd_network_acl.acl", "timeouts.create", "10m"),
+					resource.TestCheckResourceAttr("lxd_network_acl.acl", "timeouts.delete", "10m"),
+				),
+			},
+			{
+				// Ensure changing timeouts does not replace the network ACL.
+				Config: acctest.Provider() + testAccNetworkACL_withTimeouts(aclName, "1m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_network_acl.acl", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_acl.acl", "timeouts.create", "1m"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNetworkACL(aclName string) string {
 	return fmt.Sprintf(`
 resource "lxd_network_acl" "acl" {
@@ -149,6 +181,20 @@ resource "lxd_network_acl" "acl" {
   `, aclName)
 }
 
+func testAccNetworkACL_withTimeouts(aclName string, timeout string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_acl" "acl" {
+  name = "%s"
+
+  timeouts = {
+    create = "%[2]s"
+    update = "%[2]s"
+    delete = "%[2]s"
+  }
+}
+`, aclName, timeout)
+}
+
 func testAccNetworkACL_withEgressRules(aclName string) string {
 	return fmt.Sprintf(`
 resource "lxd_network_acl" "acl" {
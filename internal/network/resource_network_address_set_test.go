@@ -0,0 +1,199 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccNetworkAddressSet_basic(t *testing.T) {
+	setName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_address_set")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkAddressSet_basic(setName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "name", setName),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "description", ""),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "project", "default"),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "addresses.#", "0"),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "config.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkAddressSet_update(t *testing.T) {
+	setName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_address_set")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkAddressSet_addresses(setName, "Address set", `"10.0.0.1", "10.0.1.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "name", setName),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "description", "Address set"),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr("lxd_network_address_set.set", "addresses.*", "10.0.0.1"),
+					resource.TestCheckTypeSetElemAttr("lxd_network_address_set.set", "addresses.*", "10.0.1.0/24"),
+				),
+			},
+			{
+				// Ensure changing addresses does not replace the address set.
+				Config: acctest.Provider() + testAccNetworkAddressSet_addresses(setName, "Updated address set", `"2001:db8::/64"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_network_address_set.set", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "description", "Updated address set"),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr("lxd_network_address_set.set", "addresses.*", "2001:db8::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkAddressSet_aclReference(t *testing.T) {
+	setName := acctest.GenerateName(2, "-")
+	aclName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_address_set")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkAddressSet_aclReference(setName, aclName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "name", setName),
+					resource.TestCheckResourceAttr("lxd_network_address_set.set", "reference", "$"+setName),
+					resource.TestCheckResourceAttr("lxd_network_acl.acl", "name", aclName),
+					resource.TestCheckTypeSetElemNestedAttrs("lxd_network_acl.acl", "ingress.*", map[string]string{
+						"action": "allow",
+						"source": "$" + setName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkAddressSet_aclReferenceMissing(t *testing.T) {
+	setName := acctest.GenerateName(2, "-")
+	aclName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_address_set")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccNetworkAddressSet_aclReferenceMissing(setName, aclName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`Network address set %q not found`, setName)),
+			},
+		},
+	})
+}
+
+func TestAccNetworkAddressSet_importBasic(t *testing.T) {
+	setName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_network_address_set.set"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_address_set")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkAddressSet_addresses(setName, "Address set", `"10.0.0.1"`),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        setName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func testAccNetworkAddressSet_basic(setName string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_address_set" "set" {
+  name = "%s"
+}
+`, setName)
+}
+
+func testAccNetworkAddressSet_addresses(setName string, description string, addresses string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_address_set" "set" {
+  name        = "%s"
+  description = "%s"
+  addresses   = [%s]
+}
+`, setName, description, addresses)
+}
+
+func testAccNetworkAddressSet_aclReference(setName string, aclName string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_address_set" "set" {
+  name      = "%s"
+  addresses = ["10.0.0.0/24"]
+}
+
+resource "lxd_network_acl" "acl" {
+  name = "%s"
+
+  ingress = [
+    {
+      action = "allow"
+      source = lxd_network_address_set.set.reference
+      state  = "enabled"
+    }
+  ]
+}
+`, setName, aclName)
+}
+
+func testAccNetworkAddressSet_aclReferenceMissing(setName string, aclName string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_acl" "acl" {
+  name = "%s"
+
+  ingress = [
+    {
+      action = "allow"
+      source = "$%s"
+      state  = "enabled"
+    }
+  ]
+}
+`, aclName, setName)
+}
@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -26,13 +27,14 @@ import (
 
 // NetworkForwardModel resource data model that matches the schema.
 type NetworkForwardModel struct {
-	Network       types.String `tfsdk:"network"`
-	ListenAddress types.String `tfsdk:"listen_address"`
-	Ports         types.Set    `tfsdk:"ports"`
-	Description   types.String `tfsdk:"description"`
-	Project       types.String `tfsdk:"project"`
-	Remote        types.String `tfsdk:"remote"`
-	Config        types.Map    `tfsdk:"config"`
+	Network       types.String   `tfsdk:"network"`
+	ListenAddress types.String   `tfsdk:"listen_address"`
+	Ports         types.Set      `tfsdk:"ports"`
+	Description   types.String   `tfsdk:"description"`
+	Project       types.String   `tfsdk:"project"`
+	Remote        types.String   `tfsdk:"remote"`
+	Config        types.Map      `tfsdk:"config"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NetworkForwardPortModel resource data model that matches the schema.
@@ -85,7 +87,6 @@ func (r *NetworkForwardResource) Schema(ctx context.Context, req resource.Schema
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -141,6 +142,9 @@ func (r *NetworkForwardResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -172,6 +176,10 @@ func (r *NetworkForwardResource) Configure(_ context.Context, req resource.Confi
 	r.provider = provider
 }
 
+func (r *NetworkForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r *NetworkForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkForwardModel
 
@@ -181,6 +189,15 @@ func (r *NetworkForwardResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -240,6 +257,15 @@ func (r *NetworkForwardResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -261,6 +287,15 @@ func (r *NetworkForwardResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -316,6 +351,15 @@ func (r *NetworkForwardResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -349,6 +393,10 @@ func (r *NetworkForwardResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
 	for k, v := range fields {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
 	}
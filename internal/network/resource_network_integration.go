@@ -0,0 +1,448 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// Network integration config keys holding TLS material. These keys are
+// managed through dedicated sensitive attributes instead of the config.
+const (
+	networkIntegrationCACertKey     = "ovn.ca_cert"
+	networkIntegrationClientCertKey = "ovn.client_cert"
+	networkIntegrationClientKeyKey  = "ovn.client_key"
+)
+
+// NetworkIntegrationModel resource data model that matches the schema.
+type NetworkIntegrationModel struct {
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Type              types.String   `tfsdk:"type"`
+	CACertificate     types.String   `tfsdk:"ca_certificate"`
+	ClientCertificate types.String   `tfsdk:"client_certificate"`
+	ClientKey         types.String   `tfsdk:"client_key"`
+	Remote            types.String   `tfsdk:"remote"`
+	Config            types.Map      `tfsdk:"config"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NetworkIntegrationResource represent LXD network integration resource.
+type NetworkIntegrationResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewNetworkIntegrationResource returns a new network integration resource.
+func NewNetworkIntegrationResource() resource.Resource {
+	return &NetworkIntegrationResource{}
+}
+
+func (r NetworkIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_integration"
+}
+
+func (r NetworkIntegrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ovn"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ovn"),
+				},
+			},
+
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM encoded CA certificate used to verify the OVN interconnection database",
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM encoded client certificate used to connect to the OVN interconnection database",
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded client key used to connect to the OVN interconnection database",
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"config": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.NoneOf(
+							networkIntegrationCACertKey,
+							networkIntegrationClientCertKey,
+							networkIntegrationClientKeyKey,
+						),
+					),
+				},
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *NetworkIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r NetworkIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkIntegrationModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	err = server.CheckExtension("network_integrations")
+	if err != nil {
+		resp.Diagnostics.AddError("Network integrations are not supported", err.Error())
+		return
+	}
+
+	config, diags := plan.ToIntegrationConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationName := plan.Name.ValueString()
+	integrationReq := api.NetworkIntegrationsPost{
+		NetworkIntegrationPost: api.NetworkIntegrationPost{
+			Name: integrationName,
+		},
+		NetworkIntegrationPut: api.NetworkIntegrationPut{
+			Description: plan.Description.ValueString(),
+			Config:      config,
+		},
+		Type: plan.Type.ValueString(),
+	}
+
+	err = server.CreateNetworkIntegration(integrationReq)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create network integration %q", integrationName), err.Error())
+		return
+	}
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r NetworkIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkIntegrationModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r NetworkIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NetworkIntegrationModel
+
+	// Fetch resource model from Terraform plan.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	integrationName := plan.Name.ValueString()
+	_, etag, err := server.GetNetworkIntegration(integrationName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing network integration %q", integrationName), err.Error())
+		return
+	}
+
+	config, diags := plan.ToIntegrationConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update network integration.
+	integrationReq := api.NetworkIntegrationPut{
+		Description: plan.Description.ValueString(),
+		Config:      config,
+	}
+
+	err = server.UpdateNetworkIntegration(integrationName, integrationReq, etag)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update network integration %q", integrationName), err.Error())
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r NetworkIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkIntegrationModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	integrationName := state.Name.ValueString()
+	err = server.DeleteNetworkIntegration(integrationName)
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove network integration %q", integrationName), err.Error())
+	}
+}
+
+func (r NetworkIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "network_integration",
+		RequiredFields: []string{"name"},
+	}
+
+	fields, diag := meta.ParseImportID(req.ID)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	for k, v := range fields {
+		// Network integrations are not project specific.
+		if k == "project" {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid import ID %q", req.ID),
+				"Valid import format:\nimport lxd_network_integration.<resource> [remote:]<name>",
+			)
+			break
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
+// ToIntegrationConfig merges the user provided config with the TLS material
+// from the sensitive attributes into the network integration config.
+func (m NetworkIntegrationModel) ToIntegrationConfig(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	config, diags := common.ToConfigMap(ctx, m.Config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]types.String{
+		networkIntegrationCACertKey:     m.CACertificate,
+		networkIntegrationClientCertKey: m.ClientCertificate,
+		networkIntegrationClientKeyKey:  m.ClientKey,
+	}
+
+	for k, v := range secrets {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		config[k] = v.ValueString()
+	}
+
+	return config, nil
+}
+
+// TaintState marks the state with identity fields required to target the network integration.
+func (m NetworkIntegrationModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
+
+	return diags
+}
+
+// SyncState fetches the server's current state for a network integration and
+// updates the provided model. It then applies this updated model as the new
+// state in Terraform.
+func (r NetworkIntegrationResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m NetworkIntegrationModel, forgetOnNotFound bool) diag.Diagnostics {
+	integrationName := m.Name.ValueString()
+	integration, _, err := server.GetNetworkIntegration(integrationName)
+	if err != nil {
+		if forgetOnNotFound && errors.IsNotFoundError(err) {
+			tfState.RemoveResource(ctx)
+			return nil
+		}
+
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			fmt.Sprintf("Failed to sync state for network integration %q", integrationName), err.Error(),
+		)}
+	}
+
+	// Extract TLS material from the config. The server may omit these
+	// values, in which case the ones from the model are retained.
+	secrets := map[string]*types.String{
+		networkIntegrationCACertKey:     &m.CACertificate,
+		networkIntegrationClientCertKey: &m.ClientCertificate,
+		networkIntegrationClientKeyKey:  &m.ClientKey,
+	}
+
+	for k, v := range secrets {
+		value := integration.Config[k]
+		delete(integration.Config, k)
+
+		if value != "" {
+			*v = types.StringValue(value)
+		}
+	}
+
+	// Convert config state into schema type.
+	config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(integration.Config), m.Config)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.Name = types.StringValue(integration.Name)
+	m.Description = types.StringValue(integration.Description)
+	m.Type = types.StringValue(integration.Type)
+	m.Config = config
+
+	return tfState.Set(ctx, &m)
+}
@@ -0,0 +1,113 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccNetworkIntegration_basic(t *testing.T) {
+	integrationName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_integrations")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkIntegration_basic(integrationName, "Network integration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "name", integrationName),
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "description", "Network integration"),
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "type", "ovn"),
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "config.%", "1"),
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "config.ovn.northbound_connection", "tcp:192.0.2.1:6645"),
+				),
+			},
+			{
+				// Ensure changing description does not replace the network integration.
+				Config: acctest.Provider() + testAccNetworkIntegration_basic(integrationName, "Updated network integration"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_network_integration.ic", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_network_integration.ic", "description", "Updated network integration"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkIntegration_tlsInConfig(t *testing.T) {
+	integrationName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccNetworkIntegration_tlsInConfig(integrationName),
+				ExpectError: regexp.MustCompile(`ovn.client_key`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkIntegration_importBasic(t *testing.T) {
+	integrationName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_network_integration.ic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_integrations")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkIntegration_basic(integrationName, "Network integration"),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        integrationName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func testAccNetworkIntegration_basic(integrationName string, description string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_integration" "ic" {
+  name        = "%s"
+  description = "%s"
+
+  config = {
+    "ovn.northbound_connection" = "tcp:192.0.2.1:6645"
+  }
+}
+`, integrationName, description)
+}
+
+func testAccNetworkIntegration_tlsInConfig(integrationName string) string {
+	return fmt.Sprintf(`
+resource "lxd_network_integration" "ic" {
+  name = "%s"
+
+  config = {
+    "ovn.northbound_connection" = "ssl:192.0.2.1:6645"
+    "ovn.client_key"            = "secret"
+  }
+}
+`, integrationName)
+}
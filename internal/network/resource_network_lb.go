@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -26,14 +27,15 @@ import (
 
 // NetworkLBModel resource data model that matches the schema.
 type NetworkLBModel struct {
-	Network       types.String `tfsdk:"network"`
-	ListenAddress types.String `tfsdk:"listen_address"`
-	Ports         types.Set    `tfsdk:"port"`
-	Backends      types.Set    `tfsdk:"backend"`
-	Description   types.String `tfsdk:"description"`
-	Project       types.String `tfsdk:"project"`
-	Remote        types.String `tfsdk:"remote"`
-	Config        types.Map    `tfsdk:"config"`
+	Network       types.String   `tfsdk:"network"`
+	ListenAddress types.String   `tfsdk:"listen_address"`
+	Ports         types.Set      `tfsdk:"port"`
+	Backends      types.Set      `tfsdk:"backend"`
+	Description   types.String   `tfsdk:"description"`
+	Project       types.String   `tfsdk:"project"`
+	Remote        types.String   `tfsdk:"remote"`
+	Config        types.Map      `tfsdk:"config"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // LxdNetworkLBResource represent LXD network load balancer resource.
@@ -50,7 +52,7 @@ func (r LxdNetworkLBResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_network_lb"
 }
 
-func (r LxdNetworkLBResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r LxdNetworkLBResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"network": schema.StringAttribute{
@@ -76,7 +78,6 @@ func (r LxdNetworkLBResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -94,6 +95,9 @@ func (r LxdNetworkLBResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 
 		Blocks: map[string]schema.Block{
@@ -185,6 +189,10 @@ func (r *LxdNetworkLBResource) Configure(_ context.Context, req resource.Configu
 	r.provider = provider
 }
 
+func (r *LxdNetworkLBResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r LxdNetworkLBResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkLBModel
 
@@ -194,6 +202,15 @@ func (r LxdNetworkLBResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -260,6 +277,15 @@ func (r LxdNetworkLBResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -282,6 +308,15 @@ func (r LxdNetworkLBResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -345,6 +380,15 @@ func (r LxdNetworkLBResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -367,6 +411,27 @@ func (r LxdNetworkLBResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r LxdNetworkLBResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "network_lb",
+		RequiredFields: []string{"network", "listen_address"},
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
+	for k, v := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
 // TaintState marks the state with identity fields required to target the network load balancer.
 func (m NetworkLBModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -260,6 +260,33 @@ func TestAccNetworkLB_withBackendAndPort_noTargetPort(t *testing.T) {
 	})
 }
 
+func TestAccNetworkLB_importBasic(t *testing.T) {
+	uplinkSubnet := acctest.GenerateSubnet()
+	ovnSubnet := acctest.GenerateSubnet()
+	resourceName := "lxd_network_lb.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "network_load_balancer")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccNetworkLB_basic(uplinkSubnet, ovnSubnet),
+			},
+			{
+				Config:                               acctest.Provider() + testAccNetworkLB_basic(uplinkSubnet, ovnSubnet),
+				ResourceName:                         resourceName,
+				ImportStateId:                        "/ovn/" + uplinkSubnet.HostIPv4(200),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "listen_address",
+			},
+		},
+	})
+}
+
 func testAccNetworkLB_basic(uplinkSubnet acctest.Subnet, ovnSubnet acctest.Subnet) string {
 	lbRes := fmt.Sprintf(`
 resource "lxd_network_lb" "test" {
@@ -7,6 +7,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,10 +24,17 @@ import (
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
 
+// Network peer types.
+const (
+	networkPeerTypeLocal  = "local"
+	networkPeerTypeRemote = "remote"
+)
+
 // NetworkPeerModel is a resource data model that matches the schema.
 type NetworkPeerModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
 
 	// Source network.
 	SourceNetwork types.String `tfsdk:"source_network"`
@@ -36,9 +44,13 @@ type NetworkPeerModel struct {
 	TargetNetwork types.String `tfsdk:"target_network"`
 	TargetProject types.String `tfsdk:"target_project"`
 
-	Remote types.String `tfsdk:"remote"`
-	Config types.Map    `tfsdk:"config"`
-	Status types.String `tfsdk:"status"`
+	// Target network integration (remote peers).
+	TargetIntegration types.String `tfsdk:"target_integration"`
+
+	Remote   types.String   `tfsdk:"remote"`
+	Config   types.Map      `tfsdk:"config"`
+	Status   types.String   `tfsdk:"status"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NetworkPeerResource represent LXD network peer resource.
@@ -57,7 +69,7 @@ func (r NetworkPeerResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema for network peer resource.
-func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r NetworkPeerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -78,6 +90,19 @@ func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:     stringdefault.StaticString(""),
 			},
 
+			"type": schema.StringAttribute{
+				Description: "Type of the network peer (local or remote)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(networkPeerTypeLocal),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(networkPeerTypeLocal, networkPeerTypeRemote),
+				},
+			},
+
 			"source_network": schema.StringAttribute{
 				Description: "Name of the source network.",
 				Required:    true,
@@ -93,7 +118,6 @@ func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Project of the source network.",
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString("default"),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -101,7 +125,7 @@ func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 
 			"target_network": schema.StringAttribute{
 				Description: "Name of the target network.",
-				Required:    true,
+				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -120,6 +144,17 @@ func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 
+			"target_integration": schema.StringAttribute{
+				Description: "Name of the target network integration.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
 			"remote": schema.StringAttribute{
 				Optional: true,
 			},
@@ -134,6 +169,9 @@ func (r NetworkPeerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Network peer status",
 				Computed:    true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -153,6 +191,62 @@ func (r *NetworkPeerResource) Configure(_ context.Context, req resource.Configur
 	r.provider = provider
 }
 
+func (r NetworkPeerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if req.Config.Raw.IsNull() {
+		return
+	}
+
+	var config NetworkPeerModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case networkPeerTypeRemote:
+		if config.TargetIntegration.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_integration"),
+				"Invalid Configuration",
+				"Attribute \"target_integration\" must be set for network peers of type \"remote\".",
+			)
+		}
+
+		if !config.TargetNetwork.IsNull() || !config.TargetProject.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				"Attributes \"target_network\" and \"target_project\" cannot be set for network peers of type \"remote\".",
+			)
+		}
+	default:
+		if config.TargetNetwork.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_network"),
+				"Invalid Configuration",
+				"Attribute \"target_network\" must be set for network peers of type \"local\".",
+			)
+		}
+
+		if !config.TargetIntegration.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_integration"),
+				"Invalid Configuration",
+				"Attribute \"target_integration\" can only be set for network peers of type \"remote\".",
+			)
+		}
+	}
+}
+
+func (r *NetworkPeerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProjectAt(ctx, r.provider, req, resp, path.Root("source_project"), path.Root("remote"))
+}
+
 func (r NetworkPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkPeerModel
 
@@ -162,14 +256,25 @@ func (r NetworkPeerResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	peerName := plan.Name.ValueString()
 	remote := plan.Remote.ValueString()
 	srcNetwork := plan.SourceNetwork.ValueString()
 	srcProject := plan.SourceProject.ValueString()
 	dstNetwork := plan.TargetNetwork.ValueString()
 	dstProject := plan.TargetProject.ValueString()
+	peerType := plan.Type.ValueString()
 
-	if dstProject == "" {
+	// Target project defaults to the source project for local peers.
+	if dstProject == "" && peerType == networkPeerTypeLocal {
 		dstProject = srcProject
 	}
 
@@ -192,9 +297,11 @@ func (r NetworkPeerResource) Create(ctx context.Context, req resource.CreateRequ
 			Description: plan.Description.ValueString(),
 			Config:      config,
 		},
-		Name:          peerName,
-		TargetProject: dstProject,
-		TargetNetwork: dstNetwork,
+		Name:              peerName,
+		Type:              peerType,
+		TargetProject:     dstProject,
+		TargetNetwork:     dstNetwork,
+		TargetIntegration: plan.TargetIntegration.ValueString(),
 	}
 
 	op, err := server.CreateNetworkPeer(srcNetwork, peer)
@@ -227,6 +334,15 @@ func (r NetworkPeerResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.SourceProject.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -248,6 +364,15 @@ func (r NetworkPeerResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	peerName := plan.Name.ValueString()
 	description := plan.Description.ValueString()
 	srcProject := plan.SourceProject.ValueString()
@@ -303,6 +428,15 @@ func (r NetworkPeerResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	peerName := state.Name.ValueString()
 	srcProject := state.SourceProject.ValueString()
 	srcNetwork := state.SourceNetwork.ValueString()
@@ -346,8 +480,9 @@ func (m NetworkPeerModel) TaintState(ctx context.Context, tfState *tfsdk.State)
 	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
 	diags.Append(tfState.SetAttribute(ctx, path.Root("source_network"), m.SourceNetwork.ValueString())...)
 	diags.Append(tfState.SetAttribute(ctx, path.Root("source_project"), m.SourceProject.ValueString())...)
-	diags.Append(tfState.SetAttribute(ctx, path.Root("target_network"), m.TargetNetwork.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("target_network"), m.TargetNetwork)...)
 	diags.Append(tfState.SetAttribute(ctx, path.Root("target_project"), m.TargetProject.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("target_integration"), m.TargetIntegration)...)
 	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
 
 	return diags
@@ -380,8 +515,22 @@ func (r NetworkPeerResource) SyncState(ctx context.Context, tfState *tfsdk.State
 
 	m.Name = types.StringValue(peer.Name)
 	m.Description = types.StringValue(peer.Description)
-	m.TargetNetwork = types.StringValue(peer.TargetNetwork)
+	m.Type = types.StringValue(networkPeerTypeLocal)
+	m.TargetNetwork = types.StringNull()
 	m.TargetProject = types.StringValue(peer.TargetProject)
+	m.TargetIntegration = types.StringNull()
+
+	if peer.Type != "" {
+		m.Type = types.StringValue(peer.Type)
+	}
+
+	if peer.TargetNetwork != "" {
+		m.TargetNetwork = types.StringValue(peer.TargetNetwork)
+	}
+
+	if peer.TargetIntegration != "" {
+		m.TargetIntegration = types.StringValue(peer.TargetIntegration)
+	}
 	m.Status = types.StringValue(peer.Status)
 	m.Config = config
 
@@ -406,8 +555,25 @@ func (r NetworkPeerResource) ImportState(ctx context.Context, req resource.Impor
 
 	fields, diag := meta.ParseImportID(req.ID)
 	if diag != nil {
-		resp.Diagnostics.Append(diag)
-		return
+		// Remote peers have no target network, therefore fallback to
+		// the shorter import ID that identifies only the source peer.
+		// Target fields are populated from the server on read.
+		remoteMeta := common.ImportMetadata{
+			ResourceName: "network_peer",
+			RequiredFields: []string{
+				"name",
+				"source_project",
+				"source_network",
+			},
+		}
+
+		remoteFields, remoteDiag := remoteMeta.ParseImportID(req.ID)
+		if remoteDiag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+
+		fields = remoteFields
 	}
 
 	// Remove project field because we are extracting source and target
@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -68,6 +69,23 @@ func TestAccNetworkPeer_import(t *testing.T) {
 	})
 }
 
+func TestAccNetworkPeer_remoteInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccNetworkPeer_remoteWithTargetNetwork(),
+				ExpectError: regexp.MustCompile(`cannot be set for network peers of type "remote"`),
+			},
+			{
+				Config:      acctest.Provider() + testAccNetworkPeer_localWithTargetIntegration(),
+				ExpectError: regexp.MustCompile(`can only be set for network peers of type "remote"`),
+			},
+		},
+	})
+}
+
 func testAccNetworkPeer_basic(srcNetwork string, dstNetwork string, subnet acctest.Subnet) string {
 	peerRes := fmt.Sprintf(`
 resource "lxd_network" "network_1" {
@@ -104,6 +122,29 @@ resource "lxd_network_peer" "peer-2-1" {
 	return fmt.Sprintf("%s\n%s", ovnUplinkNetworkResource(subnet), peerRes)
 }
 
+func testAccNetworkPeer_remoteWithTargetNetwork() string {
+	return `
+resource "lxd_network_peer" "peer" {
+  name               = "remote-peer"
+  type               = "remote"
+  source_network     = "ovn1"
+  target_network     = "ovn2"
+  target_integration = "region2"
+}
+`
+}
+
+func testAccNetworkPeer_localWithTargetIntegration() string {
+	return `
+resource "lxd_network_peer" "peer" {
+  name               = "local-peer"
+  source_network     = "ovn1"
+  target_network     = "ovn2"
+  target_integration = "region2"
+}
+`
+}
+
 // ovnUplinkNetworkResource returns configuration for an OVN uplink bridge network.
 // Addressing (routes/DHCP/OVN ranges) is derived from the provided subnet.
 func ovnUplinkNetworkResource(subnet acctest.Subnet) string {
@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,13 +28,14 @@ import (
 // NetworkZoneRecordModel resource data model that
 // matches the schema.
 type NetworkZoneRecordModel struct {
-	Name        types.String `tfsdk:"name"`
-	Zone        types.String `tfsdk:"zone"`
-	Description types.String `tfsdk:"description"`
-	Entries     types.Set    `tfsdk:"entry"`
-	Project     types.String `tfsdk:"project"`
-	Remote      types.String `tfsdk:"remote"`
-	Config      types.Map    `tfsdk:"config"`
+	Name        types.String   `tfsdk:"name"`
+	Zone        types.String   `tfsdk:"zone"`
+	Description types.String   `tfsdk:"description"`
+	Entries     types.Set      `tfsdk:"entry"`
+	Project     types.String   `tfsdk:"project"`
+	Remote      types.String   `tfsdk:"remote"`
+	Config      types.Map      `tfsdk:"config"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NetworkZoneRecordResource represent LXD network zone record resource.
@@ -50,7 +52,7 @@ func (r NetworkZoneRecordResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_network_zone_record"
 }
 
-func (r NetworkZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r NetworkZoneRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -76,7 +78,6 @@ func (r NetworkZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRe
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -94,6 +95,9 @@ func (r NetworkZoneRecordResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 
 		Blocks: map[string]schema.Block{
@@ -144,6 +148,10 @@ func (r *NetworkZoneRecordResource) Configure(_ context.Context, req resource.Co
 	r.provider = provider
 }
 
+func (r *NetworkZoneRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r NetworkZoneRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkZoneRecordModel
 
@@ -154,6 +162,15 @@ func (r NetworkZoneRecordResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -216,6 +233,15 @@ func (r NetworkZoneRecordResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -238,6 +264,15 @@ func (r NetworkZoneRecordResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -298,6 +333,15 @@ func (r NetworkZoneRecordResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -332,7 +376,7 @@ func (r NetworkZoneRecordResource) ImportState(ctx context.Context, req resource
 	}
 
 	if fields["project"] == "" {
-		fields["project"] = provider_config.DefaultProject
+		fields["project"] = r.provider.Project(fields["remote"])
 	}
 
 	for k, v := range fields {
@@ -0,0 +1,202 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type ProfilesDataSourceModel struct {
+	Filter      types.String `tfsdk:"filter"`
+	Project     types.String `tfsdk:"project"`
+	AllProjects types.Bool   `tfsdk:"all_projects"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Computed.
+	Profiles []ProfilesItemModel `tfsdk:"profiles"`
+}
+
+type ProfilesItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Project     types.String `tfsdk:"project"`
+	Description types.String `tfsdk:"description"`
+	Devices     types.Map    `tfsdk:"devices"`
+	Config      types.Map    `tfsdk:"config"`
+}
+
+func NewProfilesDataSource() datasource.DataSource {
+	return &ProfilesDataSource{}
+}
+
+type ProfilesDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func (d *ProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_profiles", req.ProviderTypeName)
+}
+
+func (d *ProfilesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Description: "LXD API filter expression, for example \"config.user.role eq web\"",
+				Optional:    true,
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("all_projects")),
+				},
+			},
+
+			"all_projects": schema.BoolAttribute{
+				Description: "List profiles from all projects",
+				Optional:    true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"profiles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of profiles matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"project": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+
+						"devices": schema.MapNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed: true,
+									},
+
+									"properties": schema.MapAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProfilesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *ProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProfilesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := d.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if project == "" {
+		project = d.provider.Project(remote)
+	}
+
+	var profiles []api.Profile
+	if state.AllProjects.ValueBool() {
+		profiles, err = server.GetProfilesAllProjects()
+	} else {
+		profiles, err = server.GetProfiles()
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve profiles", err.Error())
+		return
+	}
+
+	// Profiles endpoint does not support server side filtering.
+	profiles, err = common.FilterObjects(profiles, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Failed to filter profiles", err.Error())
+		return
+	}
+
+	state.Profiles = make([]ProfilesItemModel, 0, len(profiles))
+	for _, profile := range profiles {
+		config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(profile.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		devices, diags := common.ToDeviceMapType(ctx, profile.Devices)
+		resp.Diagnostics.Append(diags...)
+
+		profileProject := profile.Project
+		if profileProject == "" {
+			profileProject = project
+		}
+
+		state.Profiles = append(state.Profiles, ProfilesItemModel{
+			Name:        types.StringValue(profile.Name),
+			Project:     types.StringValue(profileProject),
+			Description: types.StringValue(profile.Description),
+			Devices:     devices,
+			Config:      config,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
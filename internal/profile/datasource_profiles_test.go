@@ -0,0 +1,79 @@
+package profile_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccProfiles_DS_filter(t *testing.T) {
+	profileName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccProfiles_DS_filter(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.name", profileName),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.project", "default"),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.description", "Terraform provider test profile"),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.config.limits.cpu", "2"),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.devices.shared.type", "disk"),
+					resource.TestCheckResourceAttr("data.lxd_profiles.profiles", "profiles.0.devices.shared.properties.path", "/tmp/shared"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProfiles_DS_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + `
+data "lxd_profiles" "profiles" {
+  filter = "name eq"
+}
+`,
+				ExpectError: regexp.MustCompile(`Failed to filter profiles`),
+			},
+		},
+	})
+}
+
+func testAccProfiles_DS_filter(profileName string) string {
+	return fmt.Sprintf(`
+resource "lxd_profile" "profile" {
+  name        = %[1]q
+  description = "Terraform provider test profile"
+
+  config = {
+    "limits.cpu" = 2
+  }
+
+  device {
+    name = "shared"
+    type = "disk"
+
+    properties = {
+      source = "/tmp"
+      path   = "/tmp/shared"
+    }
+  }
+}
+
+data "lxd_profiles" "profiles" {
+  filter = "name eq %[1]s"
+
+  depends_on = [lxd_profile.profile]
+}
+`, profileName)
+}
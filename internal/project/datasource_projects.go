@@ -0,0 +1,142 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type ProjectsDataSourceModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Remote types.String `tfsdk:"remote"`
+
+	// Computed.
+	Projects []ProjectsItemModel `tfsdk:"projects"`
+}
+
+type ProjectsItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Config      types.Map    `tfsdk:"config"`
+}
+
+type ProjectsDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_projects", req.ProviderTypeName)
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Description: "LXD API filter expression, for example \"config.features.images eq false\"",
+				Optional:    true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of projects matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProjectsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	server, err := d.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	projects, err := server.GetProjects()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve projects", err.Error())
+		return
+	}
+
+	// Projects endpoint does not support server side filtering.
+	projects, err = common.FilterObjects(projects, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Failed to filter projects", err.Error())
+		return
+	}
+
+	state.Projects = make([]ProjectsItemModel, 0, len(projects))
+	for _, project := range projects {
+		config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(project.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		state.Projects = append(state.Projects, ProjectsItemModel{
+			Name:        types.StringValue(project.Name),
+			Description: types.StringValue(project.Description),
+			Config:      config,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,48 @@
+package project_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccProjects_DS_filter(t *testing.T) {
+	projectName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccProjects_DS_filter(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_projects.projects", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_projects.projects", "projects.0.name", projectName),
+					resource.TestCheckResourceAttr("data.lxd_projects.projects", "projects.0.description", "Terraform provider test project"),
+					resource.TestCheckResourceAttr("data.lxd_projects.projects", "projects.0.config.features.images", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjects_DS_filter(projectName string) string {
+	return fmt.Sprintf(`
+resource "lxd_project" "project" {
+  name        = %[1]q
+  description = "Terraform provider test project"
+
+  config = {
+    "features.images" = false
+  }
+}
+
+data "lxd_projects" "projects" {
+  filter = "name eq %[1]s"
+
+  depends_on = [lxd_project.project]
+}
+`, projectName)
+}
@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,10 +25,11 @@ import (
 
 // ProjectModel resource data model that matches the schema.
 type ProjectModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Remote      types.String `tfsdk:"remote"`
-	Config      types.Map    `tfsdk:"config"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Remote      types.String   `tfsdk:"remote"`
+	Config      types.Map      `tfsdk:"config"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ProjectResource represent LXD project resource.
@@ -46,7 +48,7 @@ func (r ProjectResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema for project resource.
-func (r ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r ProjectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -72,6 +74,9 @@ func (r ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"remote": schema.StringAttribute{
 				Optional: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -100,6 +105,15 @@ func (r ProjectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// Convert project config schema to map.
 	config, diag := common.ToConfigMap(ctx, plan.Config)
 	resp.Diagnostics.Append(diag...)
@@ -149,6 +163,15 @@ func (r ProjectResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	projectName := state.Name.ValueString()
 	server, err := r.provider.InstanceServer(remote, projectName, "")
@@ -171,6 +194,15 @@ func (r ProjectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	projectName := plan.Name.ValueString()
 	server, err := r.provider.InstanceServer(remote, projectName, "")
@@ -220,6 +252,15 @@ func (r ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	projectName := state.Name.ValueString()
 	server, err := r.provider.InstanceServer(remote, projectName, "")
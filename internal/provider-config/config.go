@@ -42,6 +42,10 @@ type LxdRemote struct {
 	// Bearer token authentication.
 	BearerToken string
 
+	// Project is the default project used for the remote when no project
+	// is explicitly requested.
+	Project string
+
 	// server represents a cached client connection to the remote server.
 	server lxd.Server
 }
@@ -59,20 +63,33 @@ type LxdProviderConfig struct {
 	// resource or data source does not explicitly specify a remote.
 	defaultRemote string
 
+	// defaultTimeout is the time period after which a resource action is
+	// expected to time out if the resource does not configure a timeout.
+	defaultTimeout time.Duration
+
+	// events is a map of shared instance event listeners per remote.
+	events map[string]*InstanceEventListener
+
 	// mux is a lock that handle concurrent reads/writes to the LXD config.
 	mux sync.RWMutex
 }
 
 // NewLxdProviderConfig initializes a new provider configuration from the given
-// remotes and options. At least one remote must be provided.
-func NewLxdProviderConfig(version string, remotes map[string]LxdRemote, defaultRemote string) (*LxdProviderConfig, error) {
+// remotes and options. At least one remote must be provided. If the default
+// timeout is zero, DefaultTimeout falls back to 5 minutes.
+func NewLxdProviderConfig(version string, remotes map[string]LxdRemote, defaultRemote string, defaultTimeout time.Duration) (*LxdProviderConfig, error) {
 	if len(remotes) == 0 {
 		return nil, fmt.Errorf("At least one remote must be defined in the provider configuration")
 	}
 
+	if defaultTimeout < 0 {
+		return nil, fmt.Errorf("Default timeout %q cannot be negative", defaultTimeout)
+	}
+
 	config := &LxdProviderConfig{
-		version: version,
-		remotes: builtinRemotes(),
+		version:        version,
+		remotes:        builtinRemotes(),
+		defaultTimeout: defaultTimeout,
 	}
 
 	// Validate remotes.
@@ -137,12 +154,41 @@ func (p *LxdProviderConfig) InstanceServer(remoteName string, project string, ta
 		return nil, fmt.Errorf("Remote %q is not an InstanceServer", remoteName)
 	}
 
+	if project == "" {
+		project = p.Project(remoteName)
+	}
+
 	instServer = instServer.UseProject(project)
 	instServer = instServer.UseTarget(target)
 
 	return instServer, nil
 }
 
+// InstanceEvents returns the instance event listener of the given remote.
+// The listener is shared between all resources that use the same remote,
+// so that a single event stream is established per remote.
+func (p *LxdProviderConfig) InstanceEvents(remoteName string) *InstanceEventListener {
+	remoteName = p.selectRemote(remoteName)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.events == nil {
+		p.events = make(map[string]*InstanceEventListener)
+	}
+
+	listener, ok := p.events[remoteName]
+	if !ok {
+		listener = newInstanceEventListener(func() (lxd.InstanceServer, error) {
+			return p.InstanceServer(remoteName, "", "")
+		})
+
+		p.events[remoteName] = listener
+	}
+
+	return listener
+}
+
 // ImageServer returns a LXD ImageServer client for the given remote.
 // An error is returned if the remote is not an ImageServer.
 func (p *LxdProviderConfig) ImageServer(remoteName string) (lxd.ImageServer, error) {
@@ -357,6 +403,22 @@ func (p *LxdProviderConfig) buildConnectionArgs(remote LxdRemote, userAgent stri
 	return args, nil
 }
 
+// Project returns the default project of the given remote, which is used
+// when a resource or data source does not explicitly specify a project.
+// If the remote does not configure a default project, DefaultProject is
+// returned.
+func (p *LxdProviderConfig) Project(remoteName string) string {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	project := p.remotes[p.selectRemote(remoteName)].Project
+	if project == "" {
+		return DefaultProject
+	}
+
+	return project
+}
+
 // selectRemote returns the provided remote name if it is not empty,
 // otherwise it returns the default remote name.
 func (p *LxdProviderConfig) selectRemote(remoteName string) string {
@@ -374,7 +436,13 @@ func (p *LxdProviderConfig) ToHCL() string {
 
 	var b strings.Builder
 	b.WriteString(`provider "lxd" {` + "\n")
-	fmt.Fprintf(&b, "  default_remote = %q\n\n", p.defaultRemote)
+	fmt.Fprintf(&b, "  default_remote = %q\n", p.defaultRemote)
+
+	if p.defaultTimeout > 0 {
+		fmt.Fprintf(&b, "  default_timeout = %q\n", p.defaultTimeout)
+	}
+
+	b.WriteString("\n")
 
 	builtinRemoteNames := []string{""}
 	for name := range builtinRemotes() {
@@ -410,6 +478,10 @@ func (p *LxdProviderConfig) ToHCL() string {
 			fmt.Fprintf(&b, "    server_certificate_fingerprint = %q\n", remote.ServerCertificateFingerprint)
 		}
 
+		if remote.Project != "" {
+			fmt.Fprintf(&b, "    project = %q\n", remote.Project)
+		}
+
 		b.WriteString("  }\n")
 	}
 
@@ -420,6 +492,10 @@ func (p *LxdProviderConfig) ToHCL() string {
 // DefaultTimeout returns the default time period after which a resource
 // action (read/create/update/delete) is expected to time out.
 func (p *LxdProviderConfig) DefaultTimeout() time.Duration {
+	if p.defaultTimeout > 0 {
+		return p.defaultTimeout
+	}
+
 	return 5 * time.Minute
 }
 
@@ -1,6 +1,10 @@
 package config
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
 
 func TestDetermineLXDAddress(t *testing.T) {
 	tests := []struct {
@@ -110,3 +114,111 @@ func TestDetermineLXDAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadLxcConfig(t *testing.T) {
+	configDir := t.TempDir()
+
+	config := `
+default-remote: remote1
+remotes:
+  local:
+    addr: unix://
+    public: false
+  remote1:
+    addr: https://10.0.0.1:8443
+    auth_type: tls
+    protocol: lxd
+    public: false
+  remote2:
+    addr: https://10.0.0.2
+    auth_type: tls
+    protocol: lxd
+    public: false
+  sso:
+    addr: https://10.0.0.3:8443
+    auth_type: oidc
+    protocol: lxd
+    public: false
+`
+
+	files := map[string]string{
+		"config.yml":              config,
+		"client.crt":              "global-cert",
+		"client.key":              "global-key",
+		"clientcerts/remote2.crt": "remote2-cert",
+		"clientcerts/remote2.key": "remote2-key",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(configDir, name)
+
+		err := os.MkdirAll(filepath.Dir(path), 0700)
+		if err != nil {
+			t.Fatalf("Failed to create directory for %q: %v", name, err)
+		}
+
+		err = os.WriteFile(path, []byte(content), 0600)
+		if err != nil {
+			t.Fatalf("Failed to write %q: %v", name, err)
+		}
+	}
+
+	remotes, defaultRemote, err := LoadLxcConfig(configDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if defaultRemote != "remote1" {
+		t.Fatalf("Expected default remote %q, got %q", "remote1", defaultRemote)
+	}
+
+	tests := []struct {
+		Name       string
+		Address    string
+		ClientCert string
+		ClientKey  string
+	}{
+		{
+			Name:    "local",
+			Address: "unix://",
+		},
+		{
+			Name:       "remote1",
+			Address:    "https://10.0.0.1:8443",
+			ClientCert: "global-cert",
+			ClientKey:  "global-key",
+		},
+		{
+			Name:       "remote2",
+			Address:    "https://10.0.0.2:8443",
+			ClientCert: "remote2-cert",
+			ClientKey:  "remote2-key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			remote, ok := remotes[test.Name]
+			if !ok {
+				t.Fatalf("Expected remote %q to be loaded", test.Name)
+			}
+
+			if remote.Protocol != "lxd" {
+				t.Fatalf("Expected protocol %q, got %q", "lxd", remote.Protocol)
+			}
+
+			if remote.Address != test.Address {
+				t.Fatalf("Expected address %q, got %q", test.Address, remote.Address)
+			}
+
+			if remote.ClientCertificate != test.ClientCert || remote.ClientKey != test.ClientKey {
+				t.Fatalf("Expected client certificate %q and key %q, got %q and %q", test.ClientCert, test.ClientKey, remote.ClientCertificate, remote.ClientKey)
+			}
+		})
+	}
+
+	_, ok := remotes["sso"]
+	if ok {
+		t.Fatalf("Expected remote %q using OIDC authentication to be skipped", "sso")
+	}
+}
@@ -0,0 +1,242 @@
+package config
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+)
+
+// instanceEventTypes are the LXD event types that may indicate a change
+// of the instance state.
+var instanceEventTypes = []string{"lifecycle", "operation"}
+
+// InstanceEventListener shares a single LXD event stream of a remote between
+// all the resources waiting for an instance state change. Subscribers are
+// notified whenever an event related to their instance is received.
+//
+// The event stream is connected on the first subscription. If the stream
+// cannot be established or is disconnected, subscribers are expected to fall
+// back to polling the instance state.
+type InstanceEventListener struct {
+	// connect returns the server whose event stream is listened to.
+	connect func() (lxd.InstanceServer, error)
+
+	// listener is the connected LXD event listener, or nil if not connected.
+	listener *lxd.EventListener
+
+	// subscribers is a set of active subscribers.
+	subscribers map[*instanceEventSubscriber]struct{}
+
+	// mux is a lock that handles concurrent access to the listener and
+	// its subscribers.
+	mux sync.Mutex
+}
+
+// instanceEventSubscriber represents a single subscription for the events
+// of an instance.
+type instanceEventSubscriber struct {
+	project  string
+	instance string
+	notify   chan struct{}
+}
+
+// wake notifies the subscriber without blocking. Multiple notifications
+// that were not yet received are coalesced into one.
+func (s *instanceEventSubscriber) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// newInstanceEventListener returns a new listener for the event stream of
+// the server returned by the connect function.
+func newInstanceEventListener(connect func() (lxd.InstanceServer, error)) *InstanceEventListener {
+	return &InstanceEventListener{
+		connect:     connect,
+		subscribers: make(map[*instanceEventSubscriber]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a notification whenever an event
+// related to the instance with the given name and project is received. The
+// returned function must be called to cancel the subscription. An error is
+// returned if the event stream is not available.
+func (l *InstanceEventListener) Subscribe(project string, instanceName string) (<-chan struct{}, func(), error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	err := l.start()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := &instanceEventSubscriber{
+		project:  normalizeProject(project),
+		instance: instanceName,
+		notify:   make(chan struct{}, 1),
+	}
+
+	l.subscribers[sub] = struct{}{}
+
+	unsubscribe := func() {
+		l.mux.Lock()
+		defer l.mux.Unlock()
+
+		delete(l.subscribers, sub)
+	}
+
+	return sub.notify, unsubscribe, nil
+}
+
+// start connects to the event stream of all projects, unless it is already
+// connected. It must be called with the lock held.
+func (l *InstanceEventListener) start() error {
+	if l.listener != nil {
+		return nil
+	}
+
+	server, err := l.connect()
+	if err != nil {
+		return err
+	}
+
+	listener, err := server.GetEventsAllProjects()
+	if err != nil {
+		return err
+	}
+
+	_, err = listener.AddHandler(instanceEventTypes, l.dispatch)
+	if err != nil {
+		listener.Disconnect()
+		return err
+	}
+
+	l.listener = listener
+
+	// Once the event stream is disconnected, wake all subscribers so that
+	// they refresh the instance state and fall back to polling. The stream
+	// is reconnected on the next subscription.
+	go func() {
+		_ = listener.Wait()
+
+		l.mux.Lock()
+		defer l.mux.Unlock()
+
+		if l.listener == listener {
+			l.listener = nil
+		}
+
+		for sub := range l.subscribers {
+			sub.wake()
+		}
+	}()
+
+	return nil
+}
+
+// dispatch notifies the subscribers of the instances referenced by the event.
+func (l *InstanceEventListener) dispatch(event api.Event) {
+	instances := eventInstances(event)
+	if len(instances) == 0 {
+		return
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	for sub := range l.subscribers {
+		for _, inst := range instances {
+			if sub.project == inst.project && sub.instance == inst.name {
+				sub.wake()
+				break
+			}
+		}
+	}
+}
+
+// eventInstance identifies an instance referenced by an event.
+type eventInstance struct {
+	project string
+	name    string
+}
+
+// eventInstances returns the instances referenced by the lifecycle or
+// operation event.
+func eventInstances(event api.Event) []eventInstance {
+	var urls []string
+
+	switch event.Type {
+	case "lifecycle":
+		var lifecycle api.EventLifecycle
+		err := json.Unmarshal(event.Metadata, &lifecycle)
+		if err != nil {
+			return nil
+		}
+
+		urls = []string{lifecycle.Source}
+	case "operation":
+		var op api.Operation
+		err := json.Unmarshal(event.Metadata, &op)
+		if err != nil {
+			return nil
+		}
+
+		urls = op.Resources["instances"]
+	default:
+		return nil
+	}
+
+	instances := make([]eventInstance, 0, len(urls))
+	for _, u := range urls {
+		inst, ok := parseInstanceURL(u, event.Project)
+		if ok {
+			instances = append(instances, inst)
+		}
+	}
+
+	return instances
+}
+
+// parseInstanceURL extracts the instance project and name from an API URL,
+// such as "/1.0/instances/c1?project=test" or "/1.0/instances/c1/snapshots/s1".
+// If the URL does not contain a project, the given default is used.
+func parseInstanceURL(rawURL string, defaultProject string) (eventInstance, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return eventInstance{}, false
+	}
+
+	path, ok := strings.CutPrefix(u.Path, "/1.0/instances/")
+	if !ok {
+		return eventInstance{}, false
+	}
+
+	name, _, _ := strings.Cut(path, "/")
+	if name == "" {
+		return eventInstance{}, false
+	}
+
+	project := u.Query().Get("project")
+	if project == "" {
+		project = defaultProject
+	}
+
+	return eventInstance{
+		project: normalizeProject(project),
+		name:    name,
+	}, true
+}
+
+// normalizeProject returns the default project if the given one is empty.
+func normalizeProject(project string) string {
+	if project == "" {
+		return DefaultProject
+	}
+
+	return project
+}
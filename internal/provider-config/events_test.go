@@ -0,0 +1,158 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/canonical/lxd/shared/api"
+)
+
+func TestEventInstances(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Type    string
+		Project string
+		Meta    any
+		Expect  []eventInstance
+	}{
+		{
+			Name: "Lifecycle | Default project",
+			Type: "lifecycle",
+			Meta: api.EventLifecycle{
+				Action: "instance-started",
+				Source: "/1.0/instances/c1",
+			},
+			Expect: []eventInstance{{project: "default", name: "c1"}},
+		},
+		{
+			Name:    "Lifecycle | Event project",
+			Type:    "lifecycle",
+			Project: "test",
+			Meta: api.EventLifecycle{
+				Action: "instance-stopped",
+				Source: "/1.0/instances/c1",
+			},
+			Expect: []eventInstance{{project: "test", name: "c1"}},
+		},
+		{
+			Name: "Lifecycle | URL project",
+			Type: "lifecycle",
+			Meta: api.EventLifecycle{
+				Action: "instance-ready",
+				Source: "/1.0/instances/c1?project=test",
+			},
+			Expect: []eventInstance{{project: "test", name: "c1"}},
+		},
+		{
+			Name: "Lifecycle | Instance snapshot",
+			Type: "lifecycle",
+			Meta: api.EventLifecycle{
+				Action: "instance-snapshot-created",
+				Source: "/1.0/instances/c1/snapshots/s1",
+			},
+			Expect: []eventInstance{{project: "default", name: "c1"}},
+		},
+		{
+			Name: "Lifecycle | Other entity",
+			Type: "lifecycle",
+			Meta: api.EventLifecycle{
+				Action: "network-created",
+				Source: "/1.0/networks/n1",
+			},
+			Expect: []eventInstance{},
+		},
+		{
+			Name:    "Operation | Multiple instances",
+			Type:    "operation",
+			Project: "test",
+			Meta: api.Operation{
+				Resources: map[string][]string{
+					"instances": {"/1.0/instances/c1", "/1.0/instances/c2?project=other"},
+				},
+			},
+			Expect: []eventInstance{{project: "test", name: "c1"}, {project: "other", name: "c2"}},
+		},
+		{
+			Name: "Operation | No instances",
+			Type: "operation",
+			Meta: api.Operation{
+				Resources: map[string][]string{
+					"storage_volumes": {"/1.0/storage-pools/default/volumes/custom/v1"},
+				},
+			},
+			Expect: []eventInstance{},
+		},
+		{
+			Name:   "Logging event",
+			Type:   "logging",
+			Meta:   api.EventLogging{Message: "c1"},
+			Expect: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			meta, err := json.Marshal(test.Meta)
+			if err != nil {
+				t.Fatalf("Failed to marshal event metadata: %v", err)
+			}
+
+			event := api.Event{
+				Type:     test.Type,
+				Project:  test.Project,
+				Metadata: meta,
+			}
+
+			instances := eventInstances(event)
+			if len(instances) != len(test.Expect) {
+				t.Fatalf("Expected instances %v, got %v", test.Expect, instances)
+			}
+
+			for i := range instances {
+				if instances[i] != test.Expect[i] {
+					t.Fatalf("Expected instances %v, got %v", test.Expect, instances)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceEventListenerDispatch(t *testing.T) {
+	listener := newInstanceEventListener(nil)
+
+	sub1 := &instanceEventSubscriber{project: "default", instance: "c1", notify: make(chan struct{}, 1)}
+	sub2 := &instanceEventSubscriber{project: "test", instance: "c1", notify: make(chan struct{}, 1)}
+	listener.subscribers[sub1] = struct{}{}
+	listener.subscribers[sub2] = struct{}{}
+
+	meta, err := json.Marshal(api.EventLifecycle{
+		Action: "instance-started",
+		Source: "/1.0/instances/c1",
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal event metadata: %v", err)
+	}
+
+	// Multiple events are coalesced into a single notification.
+	event := api.Event{Type: "lifecycle", Metadata: meta}
+	listener.dispatch(event)
+	listener.dispatch(event)
+
+	select {
+	case <-sub1.notify:
+	default:
+		t.Fatal("Expected notification for instance in the default project")
+	}
+
+	select {
+	case <-sub1.notify:
+		t.Fatal("Expected notifications to be coalesced")
+	default:
+	}
+
+	select {
+	case <-sub2.notify:
+		t.Fatal("Unexpected notification for instance in a different project")
+	default:
+	}
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	lxdConfig "github.com/canonical/lxd/lxc/config"
+	"github.com/canonical/lxd/shared"
+)
+
+// LxcConfigDir returns the directory of the local lxc CLI configuration.
+// The LXD_CONF environment variable takes precedence. Otherwise, the snap
+// configuration directory is used if LXD is installed as a snap, falling
+// back to the default configuration directory.
+func LxcConfigDir() string {
+	configDir := os.Getenv("LXD_CONF")
+	if configDir != "" {
+		return configDir
+	}
+
+	// Determine LXD configuration directory. First check for the presence
+	// of the /var/snap/lxd directory. If the directory exists, return
+	// snap's config path. Otherwise return the fallback path.
+	_, err := os.Stat("/var/snap/lxd")
+	if err == nil || os.IsExist(err) {
+		return os.ExpandEnv("$HOME/snap/lxd/common/config")
+	}
+
+	return os.ExpandEnv("$HOME/.config/lxc")
+}
+
+// LoadLxcConfig loads remotes from the lxc CLI configuration (config.yml)
+// located in the given directory. If the directory is empty, the default
+// lxc configuration directory is used.
+//
+// For each HTTPS remote, the client certificate and key are loaded from the
+// configuration directory, and the server certificate fingerprint is
+// computed from the certificate stored in "servercerts". Remotes using an
+// authentication method other than TLS are skipped, as they cannot be used
+// non-interactively.
+//
+// It returns the loaded remotes and the name of the default remote.
+func LoadLxcConfig(configDir string) (map[string]LxdRemote, string, error) {
+	if configDir == "" {
+		configDir = LxcConfigDir()
+	}
+
+	configDir = os.ExpandEnv(configDir)
+	configPath := filepath.Join(configDir, "config.yml")
+
+	config, err := lxdConfig.LoadConfig(configPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to load lxc config from %q: %w", configPath, err)
+	}
+
+	remotes := make(map[string]LxdRemote, len(config.Remotes))
+	for name, r := range config.Remotes {
+		if r.AuthType != "" && r.AuthType != "tls" {
+			continue
+		}
+
+		protocol := r.Protocol
+		if protocol == "" {
+			protocol = "lxd"
+		}
+
+		address, err := DetermineLXDAddress(protocol, r.Addr)
+		if err != nil {
+			return nil, "", fmt.Errorf("Invalid lxc remote %q: %w", name, err)
+		}
+
+		remote := LxdRemote{
+			Protocol: protocol,
+			Address:  address,
+			Project:  r.Project,
+		}
+
+		if protocol == "lxd" && strings.HasPrefix(address, "https:") {
+			remote.ClientCertificate, remote.ClientKey, err = readLxcClientCertificate(configDir, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			// Load server certificate and compute fingerprint if it exists.
+			// If the certificate does not exist, continue without setting
+			// the fingerprint, as the server certificate might be trusted
+			// by the system's CA store.
+			serverCertPath := filepath.Join(configDir, "servercerts", name+".crt")
+			serverCert, err := shared.ReadCert(serverCertPath)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, "", fmt.Errorf("Failed to read server certificate %q for lxc remote %q: %w", serverCertPath, name, err)
+			}
+
+			if serverCert != nil {
+				remote.ServerCertificateFingerprint = shared.CertFingerprint(serverCert)
+			}
+		}
+
+		remotes[name] = remote
+	}
+
+	defaultRemote := config.DefaultRemote
+	_, ok := remotes[defaultRemote]
+	if !ok {
+		defaultRemote = ""
+	}
+
+	return remotes, defaultRemote, nil
+}
+
+// readLxcClientCertificate reads the client certificate and key used by the
+// lxc CLI for the given remote. A remote specific certificate in the
+// "clientcerts" directory takes precedence over the global client certificate.
+// Empty values are returned if no client certificate exists.
+func readLxcClientCertificate(configDir string, remoteName string) (cert string, key string, err error) {
+	candidates := [][2]string{
+		{
+			filepath.Join(configDir, "clientcerts", remoteName+".crt"),
+			filepath.Join(configDir, "clientcerts", remoteName+".key"),
+		},
+		{
+			filepath.Join(configDir, "client.crt"),
+			filepath.Join(configDir, "client.key"),
+		},
+	}
+
+	for _, paths := range candidates {
+		certPath, keyPath := paths[0], paths[1]
+
+		if !shared.PathExists(certPath) || !shared.PathExists(keyPath) {
+			continue
+		}
+
+		certPEM, err := os.ReadFile(certPath)
+		if err != nil {
+			return "", "", fmt.Errorf("Failed to read client certificate %q: %w", certPath, err)
+		}
+
+		keyPEM, err := os.ReadFile(keyPath)
+		if err != nil {
+			return "", "", fmt.Errorf("Failed to read client key %q: %w", keyPath, err)
+		}
+
+		return string(certPEM), string(keyPEM), nil
+	}
+
+	return "", "", nil
+}
@@ -3,11 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/auth"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/cluster"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/functions"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/image"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/instance"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/network"
@@ -27,12 +35,28 @@ import (
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/truststore"
 )
 
+// Environment variables used to configure a remote.
+const (
+	envRemote            = "LXD_REMOTE"
+	envAddress           = "LXD_ADDR"
+	envBearerToken       = "LXD_BEARER_TOKEN"
+	envClientCertificate = "LXD_CLIENT_CERT"
+	envClientKey         = "LXD_CLIENT_KEY"
+	envServerFingerprint = "LXD_SERVER_FINGERPRINT"
+	envProject           = "LXD_PROJECT"
+)
+
+// envRemoteDefaultName is the name of the remote configured from environment
+// variables when LXD_REMOTE is not set.
+const envRemoteDefaultName = "local"
+
 // LxdProviderRemoteModel represents provider's schema remote.
 type LxdProviderRemoteModel struct {
 	Name                         types.String `tfsdk:"name"`
 	Address                      types.String `tfsdk:"address"`
 	Protocol                     types.String `tfsdk:"protocol"`
 	TrustToken                   types.String `tfsdk:"trust_token"`
+	TrustTokenFile               types.String `tfsdk:"trust_token_file"`
 	BearerToken                  types.String `tfsdk:"bearer_token"`
 	BearerTokenFile              types.String `tfsdk:"bearer_token_file"`
 	ClientKey                    types.String `tfsdk:"client_key"`
@@ -40,12 +64,17 @@ type LxdProviderRemoteModel struct {
 	ClientCertificate            types.String `tfsdk:"client_certificate"`
 	ClientCertificateFile        types.String `tfsdk:"client_certificate_file"`
 	ServerCertificateFingerprint types.String `tfsdk:"server_certificate_fingerprint"`
+	Project                      types.String `tfsdk:"project"`
 }
 
 // LxdProviderModel represents provider's schema.
 type LxdProviderModel struct {
-	Remotes       []LxdProviderRemoteModel `tfsdk:"remote"`
-	DefaultRemote types.String             `tfsdk:"default_remote"`
+	Remotes        []LxdProviderRemoteModel `tfsdk:"remote"`
+	DefaultRemote  types.String             `tfsdk:"default_remote"`
+	Project        types.String             `tfsdk:"project"`
+	DefaultTimeout types.String             `tfsdk:"default_timeout"`
+	UseLxcConfig   types.Bool               `tfsdk:"use_lxc_config"`
+	ConfigDir      types.String             `tfsdk:"config_dir"`
 }
 
 // LxdProvider ...
@@ -74,6 +103,35 @@ func (p *LxdProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 				Description: "Name of the default LXD remote to use when no remote is specified in the resource. If two or more remotes are defined, one must be set as the default.",
 			},
+
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default LXD project used when no project is specified in the resource or remote. Defaults to \"default\".",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"default_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default timeout for resource operations when no timeout is configured in the resource. A duration string, such as \"10m\". Defaults to \"5m\".",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"use_lxc_config": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Load remotes, client certificates, and trusted server certificates from the local lxc CLI configuration. Remotes defined in the provider configuration take precedence.",
+			},
+
+			"config_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the lxc CLI configuration directory. Defaults to $LXD_CONF, or the default lxc configuration directory.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 
 		Blocks: map[string]schema.Block{
@@ -108,6 +166,20 @@ func (p *LxdProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 							Description: "The trust token used for initial authentication with the LXD remote.",
 							Validators: []validator.String{
 								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("trust_token_file"),
+									path.MatchRelative().AtParent().AtName("bearer_token"),
+									path.MatchRelative().AtParent().AtName("bearer_token_file"),
+								),
+							},
+						},
+
+						"trust_token_file": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Path to the file containing the trust token used for initial authentication with the LXD remote.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("trust_token"),
 									path.MatchRelative().AtParent().AtName("bearer_token"),
 									path.MatchRelative().AtParent().AtName("bearer_token_file"),
 								),
@@ -200,6 +272,14 @@ func (p *LxdProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 							Optional:    true,
 							Description: "SHA-256 fingerprint of the remote server's TLS certificate. Used to pin and verify the server certificate.",
 						},
+
+						"project": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default LXD project used for the remote when no project is specified in the resource. Overrides the provider's project.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
 					},
 				},
 			},
@@ -207,6 +287,25 @@ func (p *LxdProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 	}
 }
 
+func (p *LxdProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data LxdProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lxc configuration directory is used only if loading of the
+	// lxc configuration is enabled.
+	if !data.ConfigDir.IsNull() && !data.UseLxcConfig.IsUnknown() && !data.UseLxcConfig.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_dir"),
+			"Invalid provider configuration",
+			`Attribute "use_lxc_config" must be set to true when "config_dir" is specified`,
+		)
+	}
+}
+
 func (p *LxdProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data LxdProviderModel
 
@@ -217,6 +316,29 @@ func (p *LxdProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	remotes := make(map[string]provider_config.LxdRemote)
 	defRemote := data.DefaultRemote.ValueString()
 
+	// Merge remote configuration from environment variables. Explicitly
+	// named remote becomes the default one unless configured otherwise.
+	data.Remotes = applyEnvRemote(data.Remotes)
+	if defRemote == "" {
+		defRemote = os.Getenv(envRemote)
+	}
+
+	// Load remotes from the lxc CLI configuration. Remotes defined in
+	// Terraform schema are applied afterwards and take precedence.
+	if data.UseLxcConfig.ValueBool() {
+		lxcRemotes, lxcDefaultRemote, err := provider_config.LoadLxcConfig(data.ConfigDir.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to load lxc configuration", err.Error())
+			return
+		}
+
+		maps.Copy(remotes, lxcRemotes)
+
+		if defRemote == "" {
+			defRemote = lxcDefaultRemote
+		}
+	}
+
 	// Read remotes from Terraform schema.
 	for _, remote := range data.Remotes {
 		name := remote.Name.ValueString()
@@ -248,6 +370,22 @@ func (p *LxdProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			}
 		}
 
+		// Parse trust token.
+		trustToken := remote.TrustToken.ValueString()
+		if trustToken == "" {
+			trustTokenFile := remote.TrustTokenFile.ValueString()
+
+			if trustTokenFile != "" {
+				content, err := os.ReadFile(trustTokenFile)
+				if err != nil {
+					resp.Diagnostics.AddError("Failed to read trust token file", err.Error())
+					return
+				}
+
+				trustToken = strings.TrimSpace(string(content))
+			}
+		}
+
 		// Parse client certificate.
 		clientCertificate := remote.ClientCertificate.ValueString()
 		if clientCertificate == "" {
@@ -285,19 +423,66 @@ func (p *LxdProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			return
 		}
 
+		// Values may originate from both Terraform schema and environment
+		// variables, therefore ensure authentication methods do not conflict.
+		if bearerToken != "" && trustToken != "" {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Conflicting authentication methods for remote %q", name),
+				fmt.Sprintf("Bearer token cannot be used together with trust token. Check both the provider configuration and the %s environment variable.", envBearerToken),
+			)
+			return
+		}
+
+		if bearerToken != "" && clientCertificate != "" {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Conflicting authentication methods for remote %q", name),
+				fmt.Sprintf("Bearer token cannot be used together with TLS client certificate and key. Check both the provider configuration and the %s, %s, and %s environment variables.", envBearerToken, envClientCertificate, envClientKey),
+			)
+			return
+		}
+
 		remotes[name] = provider_config.LxdRemote{
 			Address:                      address,
 			Protocol:                     protocol,
-			TrustToken:                   remote.TrustToken.ValueString(),
+			TrustToken:                   trustToken,
 			BearerToken:                  bearerToken,
 			ClientKey:                    clientKey,
 			ClientCertificate:            clientCertificate,
 			ServerCertificateFingerprint: remote.ServerCertificateFingerprint.ValueString(),
+			Project:                      remote.Project.ValueString(),
+		}
+	}
+
+	// Apply the provider's default project to remotes that do not
+	// configure their own.
+	defProject := data.Project.ValueString()
+	if defProject != "" {
+		for name, remote := range remotes {
+			if remote.Project == "" {
+				remote.Project = defProject
+				remotes[name] = remote
+			}
+		}
+	}
+
+	// Parse default timeout for resource operations.
+	var defTimeout time.Duration
+	if !data.DefaultTimeout.IsNull() {
+		var err error
+
+		defTimeout, err = time.ParseDuration(data.DefaultTimeout.ValueString())
+		if err != nil || defTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_timeout"),
+				"Invalid default timeout",
+				fmt.Sprintf("Default timeout %q must be a positive duration, such as \"10m\" or \"1h\".", data.DefaultTimeout.ValueString()),
+			)
+			return
 		}
 	}
 
 	// Initialize LXD provider configuration.
-	lxdProvider, err := provider_config.NewLxdProviderConfig(p.version, remotes, defRemote)
+	lxdProvider, err := provider_config.NewLxdProviderConfig(p.version, remotes, defRemote, defTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to initialize LXD provider", err.Error())
 		return
@@ -308,36 +493,49 @@ func (p *LxdProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	tflog.Debug(ctx, "LXD Provider configured", map[string]any{
 		"version":        p.version,
 		"default_remote": defRemote,
+		"project":        defProject,
+		"timeout":        lxdProvider.DefaultTimeout().String(),
 		"remotes_count":  len(remotes),
 	})
 
 	resp.ResourceData = lxdProvider
 	resp.DataSourceData = lxdProvider
+	resp.EphemeralResourceData = lxdProvider
+	resp.ActionData = lxdProvider
 }
 
 func (p *LxdProvider) Resources(_ context.Context) []func() resource.Resource {
 	resources := []func() resource.Resource{
 		auth.NewAuthGroupResource,
 		auth.NewAuthIdentityResource,
+		cluster.NewClusterMemberResource,
+		cluster.NewClusterGroupResource,
 		image.NewImageResource,
+		image.NewImageExportResource,
 		instance.NewInstanceResource,
+		instance.NewInstanceBackupResource,
 		instance.NewInstanceFileResource,
 		instance.NewInstanceSnapshotResource,
 		instance.NewInstanceDeviceResource,
 		network.NewNetworkResource,
 		network.NewNetworkAclResource,
+		network.NewNetworkAddressSetResource,
 		network.NewNetworkForwardResource,
+		network.NewNetworkIntegrationResource,
 		network.NewNetworkLBResource,
 		network.NewNetworkPeerResource,
 		network.NewNetworkZoneResource,
 		network.NewNetworkZoneRecordResource,
 		profile.NewProfileResource,
 		project.NewProjectResource,
+		server.NewPlacementScriptletResource,
+		server.NewServerConfigResource,
 		storage.NewStorageBucketResource,
 		storage.NewStorageBucketKeyResource,
 		storage.NewStoragePoolResource,
 		storage.NewStorageVolumeResource,
 		storage.NewStorageVolumeCopyResource,
+		storage.NewStorageVolumeSnapshotResource,
 		truststore.NewTrustCertificateResource,
 		truststore.NewTrustTokenResource,
 	}
@@ -354,12 +552,109 @@ func (p *LxdProvider) DataSources(_ context.Context) []func() datasource.DataSou
 	return []func() datasource.DataSource{
 		auth.NewAuthGroupDataSource,
 		auth.NewAuthIdentityDataSource,
+		cluster.NewClusterGroupsDataSource,
 		image.NewImageDataSource,
+		image.NewImagesDataSource,
 		instance.NewInstanceDataSource,
+		instance.NewInstancesDataSource,
 		network.NewNetworkDataSource,
+		network.NewNetworksDataSource,
 		profile.NewProfileDataSource,
+		profile.NewProfilesDataSource,
 		project.NewProjectDataSource,
+		project.NewProjectsDataSource,
 		server.NewInfoDataSource,
 		storage.NewStoragePoolDataSource,
+		storage.NewStorageVolumesDataSource,
+	}
+}
+
+func (p *LxdProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		auth.NewAuthIdentityTokenEphemeralResource,
+		truststore.NewTrustTokenEphemeralResource,
+	}
+}
+
+func (p *LxdProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		instance.NewInstanceExecAction,
+		instance.NewInstanceRestartAction,
+		instance.NewInstanceSnapshotRestoreAction,
+		instance.NewInstanceStartAction,
+		instance.NewInstanceStopAction,
 	}
 }
+
+func (p *LxdProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewBytesToLxdFunction,
+		functions.NewCertFingerprintFunction,
+		functions.NewLxdToBytesFunction,
+		functions.NewParseImageRefFunction,
+		functions.NewParseImportIDFunction,
+		functions.NewTrustTokenDecodeFunction,
+	}
+}
+
+// applyEnvRemote merges the remote configuration from environment variables
+// into the given remotes. The remote is identified by LXD_REMOTE, or named
+// "local" if the variable is not set.
+//
+// If a remote with a matching name is defined in the Terraform configuration,
+// environment variables only fill the unset values, so the configuration takes
+// precedence. Otherwise, a new remote is added if LXD_ADDR is set.
+func applyEnvRemote(remotes []LxdProviderRemoteModel) []LxdProviderRemoteModel {
+	name := os.Getenv(envRemote)
+	if name == "" {
+		name = envRemoteDefaultName
+	}
+
+	index := slices.IndexFunc(remotes, func(r LxdProviderRemoteModel) bool {
+		return r.Name.ValueString() == name
+	})
+
+	if index < 0 {
+		address := os.Getenv(envAddress)
+		if address == "" {
+			return remotes
+		}
+
+		remotes = append(remotes, LxdProviderRemoteModel{
+			Name:    types.StringValue(name),
+			Address: types.StringValue(address),
+		})
+
+		index = len(remotes) - 1
+	}
+
+	remote := &remotes[index]
+
+	// envString returns the value of the environment variable as
+	// a string value if it is set and the current value is null.
+	envString := func(current types.String, key string) types.String {
+		value := os.Getenv(key)
+		if !current.IsNull() || value == "" {
+			return current
+		}
+
+		return types.StringValue(value)
+	}
+
+	if remote.BearerTokenFile.IsNull() {
+		remote.BearerToken = envString(remote.BearerToken, envBearerToken)
+	}
+
+	if remote.ClientCertificateFile.IsNull() {
+		remote.ClientCertificate = envString(remote.ClientCertificate, envClientCertificate)
+	}
+
+	if remote.ClientKeyFile.IsNull() {
+		remote.ClientKey = envString(remote.ClientKey, envClientKey)
+	}
+
+	remote.ServerCertificateFingerprint = envString(remote.ServerCertificateFingerprint, envServerFingerprint)
+	remote.Project = envString(remote.Project, envProject)
+
+	return remotes
+}
@@ -316,6 +316,184 @@ func TestAccProvider_requireDefaultRemote(t *testing.T) {
 	})
 }
 
+func TestAccProvider_lxcConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure provider connects using the default remote from the lxc CLI configuration.
+				Config: testAccProvider_lxcConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "project", "default"),
+					resource.TestCheckResourceAttrSet("lxd_noop.noop", "server_version"),
+				),
+			},
+			{
+				// Ensure remotes defined in the provider configuration take precedence.
+				Config: testAccProvider_lxcConfigOverride(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "remote", "local"),
+					resource.TestCheckResourceAttr("lxd_noop.noop", "auth_user_method", "unix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_lxcConfigInvalidDir(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure an error is returned when config_dir is set without use_lxc_config.
+				Config:      testAccProvider_lxcConfigDir(t.TempDir(), false),
+				ExpectError: regexp.MustCompile(`Attribute "use_lxc_config" must be set to true`),
+				PlanOnly:    true,
+			},
+			{
+				// Ensure an error is returned when the lxc configuration does not exist.
+				Config:      testAccProvider_lxcConfigDir(t.TempDir(), true),
+				ExpectError: regexp.MustCompile(`Failed to load lxc configuration`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func TestAccProvider_envRemote(t *testing.T) {
+	t.Setenv("LXD_REMOTE", "env-remote")
+	t.Setenv("LXD_ADDR", "unix://")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure provider connects using the remote configured from environment variables.
+				Config: testAccProvider_empty(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "project", "default"),
+					resource.TestCheckResourceAttr("lxd_noop.noop", "auth_user_method", "unix"),
+				),
+			},
+			{
+				// Ensure environment remote is used as default remote alongside other remotes.
+				Config: testAccProvider_envRemoteWithOtherRemote(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "auth_user_method", "unix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_envRemoteConflict(t *testing.T) {
+	t.Setenv("LXD_REMOTE", "https-remote")
+	t.Setenv("LXD_CLIENT_CERT", "some-cert")
+	t.Setenv("LXD_CLIENT_KEY", "some-key")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure an error is returned when bearer token from the configuration
+				// is combined with client certificate from environment variables.
+				Config:      testAccProvider_bearerToken("some-token"),
+				ExpectError: regexp.MustCompile(`Conflicting authentication methods for remote "https-remote"`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_envRemoteTrustTokenConflict(t *testing.T) {
+	t.Setenv("LXD_REMOTE", "tf-remote")
+	t.Setenv("LXD_BEARER_TOKEN", "some-token")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure an error is returned when trust token from the configuration
+				// is combined with bearer token from environment variables.
+				Config:      testAccProvider_trustToken("some-cert", "some-key", "some-trust-token", ""),
+				ExpectError: regexp.MustCompile(`Bearer token cannot be used together with trust token`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_defaultProject(t *testing.T) {
+	projectName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure provider-level project is used when resource project is not set.
+				Config: testAccProvider_defaultProject(projectName, projectName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "project", projectName),
+					resource.TestCheckResourceAttr("lxd_noop.noop", "auth_user_method", "unix"),
+				),
+			},
+			{
+				// Ensure remote project takes precedence over the provider-level project.
+				Config: testAccProvider_defaultProject(projectName, "default", projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "project", projectName),
+				),
+			},
+			{
+				// Ensure resource project takes precedence over the remote project.
+				Config: testAccProvider_defaultProjectOverride(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "project", "default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_defaultTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure provider accepts a valid default timeout.
+				Config: testAccProvider_defaultTimeout("10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_noop.noop", "auth_user_method", "unix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_defaultTimeoutInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvider_defaultTimeout("ten minutes"),
+				ExpectError: regexp.MustCompile(`Invalid default timeout`),
+				PlanOnly:    true,
+			},
+			{
+				Config:      testAccProvider_defaultTimeout("-5m"),
+				ExpectError: regexp.MustCompile(`Invalid default timeout`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 // testAccProvider_unixSocket returns a provider config that uses the default unix socket.
 func testAccProvider_unixSocket() string {
 	return `
@@ -548,3 +726,134 @@ provider "lxd" {
 resource "lxd_noop" "noop" {}
 `
 }
+
+// testAccProvider_lxcConfig returns a provider config that loads remotes from the lxc CLI configuration.
+func testAccProvider_lxcConfig() string {
+	return `
+provider "lxd" {
+  use_lxc_config = true
+}
+
+resource "lxd_noop" "noop" {}
+`
+}
+
+// testAccProvider_lxcConfigOverride returns a provider config that loads remotes from the
+// lxc CLI configuration and overrides the "local" remote.
+func testAccProvider_lxcConfigOverride() string {
+	return `
+provider "lxd" {
+  use_lxc_config = true
+  default_remote = "local"
+
+  remote {
+    name    = "local"
+    address = "unix://"
+  }
+}
+
+resource "lxd_noop" "noop" {
+  remote = "local"
+}
+`
+}
+
+// testAccProvider_lxcConfigDir returns a provider config that loads remotes from the
+// given lxc CLI configuration directory.
+func testAccProvider_lxcConfigDir(configDir string, useLxcConfig bool) string {
+	return fmt.Sprintf(`
+provider "lxd" {
+  use_lxc_config = %v
+  config_dir     = %q
+}
+
+resource "lxd_noop" "noop" {}
+`, useLxcConfig, configDir)
+}
+
+// testAccProvider_empty returns an empty provider config.
+func testAccProvider_empty() string {
+	return `
+provider "lxd" {}
+
+resource "lxd_noop" "noop" {}
+`
+}
+
+// testAccProvider_envRemoteWithOtherRemote returns a provider config with a remote
+// in addition to the remote configured from environment variables.
+func testAccProvider_envRemoteWithOtherRemote() string {
+	return `
+provider "lxd" {
+  remote {
+    name    = "other-remote"
+    address = "https://127.0.0.1:8443"
+  }
+}
+
+resource "lxd_noop" "noop" {}
+`
+}
+
+// testAccProvider_defaultProject returns a provider config with the given
+// provider-level and remote projects, and a resource without a project.
+func testAccProvider_defaultProject(projectName string, providerProject string, remoteProject string) string {
+	return fmt.Sprintf(`
+provider "lxd" {
+  project = %q
+
+  remote {
+    name    = "local"
+    address = "unix://"
+    project = %q
+  }
+}
+
+resource "lxd_project" "project1" {
+  name = %q
+}
+
+resource "lxd_noop" "noop" {
+  depends_on = [lxd_project.project1]
+}
+`, providerProject, remoteProject, projectName)
+}
+
+// testAccProvider_defaultProjectOverride returns a provider config with the
+// remote project, and a resource that overrides it with the default project.
+func testAccProvider_defaultProjectOverride(projectName string) string {
+	return fmt.Sprintf(`
+provider "lxd" {
+  remote {
+    name    = "local"
+    address = "unix://"
+    project = %q
+  }
+}
+
+resource "lxd_project" "project1" {
+  name = %q
+}
+
+resource "lxd_noop" "noop" {
+  project = "default"
+}
+`, projectName, projectName)
+}
+
+// testAccProvider_defaultTimeout returns a provider config with the given
+// default timeout.
+func testAccProvider_defaultTimeout(timeout string) string {
+	return fmt.Sprintf(`
+provider "lxd" {
+  default_timeout = %q
+
+  remote {
+    name    = "local"
+    address = "unix://"
+  }
+}
+
+resource "lxd_noop" "noop" {}
+`, timeout)
+}
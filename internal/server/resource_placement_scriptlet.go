@@ -0,0 +1,391 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+	"go.starlark.net/syntax"
+)
+
+const (
+	// placementScriptletConfigKey is the server config key holding the
+	// instance placement scriptlet.
+	placementScriptletConfigKey = "instances.placement.scriptlet"
+
+	// placementScriptletFunction is the function that LXD calls from the
+	// instance placement scriptlet.
+	placementScriptletFunction = "instance_placement"
+)
+
+// PlacementScriptletModel resource data model that matches the schema.
+type PlacementScriptletModel struct {
+	Content    types.String `tfsdk:"content"`
+	SourcePath types.String `tfsdk:"source_path"`
+	Remote     types.String `tfsdk:"remote"`
+
+	// Computed.
+	Checksum types.String `tfsdk:"checksum"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PlacementScriptletResource represent LXD instance placement scriptlet resource.
+type PlacementScriptletResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewPlacementScriptletResource returns a new instance placement scriptlet resource.
+func NewPlacementScriptletResource() resource.Resource {
+	return &PlacementScriptletResource{}
+}
+
+func (r PlacementScriptletResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_placement_scriptlet"
+}
+
+func (r PlacementScriptletResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"source_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					// Specify all attributes at one field to
+					// produce only one meaningful error.
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("source_path"),
+						path.MatchRoot("content"),
+					),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed.
+
+			"checksum": schema.StringAttribute{
+				Computed: true,
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *PlacementScriptletResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+// ModifyPlan parses the scriptlet locally, so that syntax errors are
+// reported before apply, and plans its checksum.
+func (r PlacementScriptletResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to do on destroy.
+		return
+	}
+
+	var plan PlacementScriptletModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cannot parse the scriptlet if its source is not yet known.
+	if plan.Content.IsUnknown() || plan.SourcePath.IsUnknown() {
+		return
+	}
+
+	scriptlet, err := plan.scriptlet()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_path"), "Failed to read placement scriptlet", err.Error())
+		return
+	}
+
+	err = validatePlacementScriptlet(scriptlet)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid placement scriptlet", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), scriptletChecksum(scriptlet))...)
+}
+
+func (r PlacementScriptletResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PlacementScriptletModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	diags = r.applyScriptlet(server, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r PlacementScriptletResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PlacementScriptletModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r PlacementScriptletResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PlacementScriptletModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	diags = r.applyScriptlet(server, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the placement scriptlet from the server configuration.
+func (r PlacementScriptletResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PlacementScriptletModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	err = updateServerConfig(server, nil, map[string]string{placementScriptletConfigKey: ""})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove placement scriptlet", err.Error())
+	}
+}
+
+// applyScriptlet uploads the placement scriptlet from the model to the server.
+func (r PlacementScriptletResource) applyScriptlet(server lxd.InstanceServer, m PlacementScriptletModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scriptlet, err := m.scriptlet()
+	if err != nil {
+		diags.AddError("Failed to read placement scriptlet", err.Error())
+		return diags
+	}
+
+	err = updateServerConfig(server, map[string]string{placementScriptletConfigKey: scriptlet}, nil)
+	if err != nil {
+		diags.AddError("Failed to update placement scriptlet", err.Error())
+		return diags
+	}
+
+	return diags
+}
+
+// SyncState fetches the placement scriptlet from the server and updates the
+// provided model. It then applies this updated model as the new state in
+// Terraform. The resource is removed from the state if the scriptlet is no
+// longer set.
+func (r PlacementScriptletResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m PlacementScriptletModel) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	apiServer, _, err := server.GetServer()
+	if err != nil {
+		respDiags.AddError("Failed to retrieve placement scriptlet", err.Error())
+		return respDiags
+	}
+
+	scriptlet := apiServer.Config[placementScriptletConfigKey]
+	if scriptlet == "" {
+		tfState.RemoveResource(ctx)
+		return nil
+	}
+
+	// Track the live scriptlet when it is provided inline. When it is
+	// loaded from a file, drift is detected through the checksum.
+	if !m.Content.IsNull() {
+		m.Content = types.StringValue(scriptlet)
+	}
+
+	m.Checksum = types.StringValue(scriptletChecksum(scriptlet))
+
+	return tfState.Set(ctx, &m)
+}
+
+// scriptlet returns the placement scriptlet either from the content or
+// from the source file.
+func (m PlacementScriptletModel) scriptlet() (string, error) {
+	if !m.Content.IsNull() {
+		return m.Content.ValueString(), nil
+	}
+
+	sourcePath, err := homedir.Expand(m.SourcePath.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("Unable to determine source file path: %w", err)
+	}
+
+	content, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return "", fmt.Errorf("Unable to read source file %q: %w", sourcePath, err)
+	}
+
+	return string(content), nil
+}
+
+// validatePlacementScriptlet parses the Starlark placement scriptlet and
+// ensures it defines the function that LXD calls for instance placement.
+func validatePlacementScriptlet(scriptlet string) error {
+	// Be permissive about the language dialect, as LXD validates the
+	// scriptlet itself when it is applied.
+	opts := syntax.FileOptions{
+		Set:             true,
+		While:           true,
+		TopLevelControl: true,
+		GlobalReassign:  true,
+		Recursion:       true,
+	}
+
+	file, err := opts.Parse("placement.star", scriptlet, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range file.Stmts {
+		def, ok := stmt.(*syntax.DefStmt)
+		if ok && def.Name.Name == placementScriptletFunction {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Placement scriptlet must define function %q", placementScriptletFunction)
+}
+
+// scriptletChecksum returns the SHA-256 checksum of the scriptlet.
+func scriptletChecksum(scriptlet string) string {
+	hash := sha256.Sum256([]byte(scriptlet))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,95 @@
+package server_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+const testPlacementScriptlet = `
+def instance_placement(request, candidate_members):
+    log_info("Placing instance: ", request.name)
+    return
+`
+
+func TestAccPlacementScriptlet_content(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "instances_placement_scriptlet")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccPlacementScriptlet_content(testPlacementScriptlet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_placement_scriptlet.scriptlet", "content", testPlacementScriptlet),
+					resource.TestCheckResourceAttrSet("lxd_placement_scriptlet.scriptlet", "checksum"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlacementScriptlet_sourcePath(t *testing.T) {
+	sourcePath := filepath.Join(t.TempDir(), "placement.star")
+	err := os.WriteFile(sourcePath, []byte(testPlacementScriptlet), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIExtensions(t, "instances_placement_scriptlet")
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccPlacementScriptlet_sourcePath(sourcePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_placement_scriptlet.scriptlet", "source_path", sourcePath),
+					resource.TestCheckResourceAttrSet("lxd_placement_scriptlet.scriptlet", "checksum"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlacementScriptlet_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccPlacementScriptlet_content("def instance_placement(request, candidate_members)\n"),
+				ExpectError: regexp.MustCompile("Invalid placement scriptlet"),
+			},
+			{
+				Config:      acctest.Provider() + testAccPlacementScriptlet_content("def placement(request, candidate_members):\n    return\n"),
+				ExpectError: regexp.MustCompile(`must define function "instance_placement"`),
+			},
+		},
+	})
+}
+
+func testAccPlacementScriptlet_content(content string) string {
+	return fmt.Sprintf(`
+resource "lxd_placement_scriptlet" "scriptlet" {
+  content = %q
+}
+	`, content)
+}
+
+func testAccPlacementScriptlet_sourcePath(sourcePath string) string {
+	return fmt.Sprintf(`
+resource "lxd_placement_scriptlet" "scriptlet" {
+  source_path = %q
+}
+	`, sourcePath)
+}
@@ -0,0 +1,620 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// ServerConfigModel represents the LXD server configuration managed by
+// Terraform.
+type ServerConfigModel struct {
+	Config          types.Map      `tfsdk:"config"`
+	MemberOverrides types.Map      `tfsdk:"member_overrides"`
+	Members         types.Map      `tfsdk:"members"`
+	Remote          types.String   `tfsdk:"remote"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ServerConfigMemberModel represents a per-member server configuration override.
+type ServerConfigMemberModel struct {
+	Config types.Map `tfsdk:"config"`
+}
+
+// ServerConfigResource represents LXD server configuration resource.
+type ServerConfigResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewServerConfigResource returns a new server configuration resource.
+func NewServerConfigResource() resource.Resource {
+	return &ServerConfigResource{}
+}
+
+func (r ServerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_config"
+}
+
+func (r ServerConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// Contains global and default local (member-specific) server configuration.
+			"config": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+
+			// Contains only local (member-specific) server configuration that
+			// overrides the default values defined in "config".
+			"member_overrides": schema.MapNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"config": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+
+			// Contains the resolved local (member-specific) config for all cluster members.
+			"members": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *ServerConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *ServerConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to do on destroy.
+		return
+	}
+
+	var plan ServerConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cannot expand members if config or member_overrides are not yet known.
+	if plan.Config.IsUnknown() || plan.MemberOverrides.IsUnknown() {
+		return
+	}
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	_, memberConfigs, err := plan.ParseServerConfigs(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse server configuration", err.Error())
+		return
+	}
+
+	membersValue, diags := toServerConfigMembersMapType(ctx, memberConfigs)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Plan.SetAttribute(ctx, path.Root("members"), membersValue)
+}
+
+func (r ServerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServerConfigModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	serverConfig, memberConfigs, err := plan.ParseServerConfigs(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse server configuration", err.Error())
+		return
+	}
+
+	diags = r.applyServerConfigs(server, serverConfig, memberConfigs, nil, nil)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ServerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServerConfigModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ServerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServerConfigModel
+	var state ServerConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	serverConfig, memberConfigs, err := plan.ParseServerConfigs(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse server configuration", err.Error())
+		return
+	}
+
+	// Parse the previously managed configuration to determine which
+	// keys are no longer managed and have to be unset.
+	oldServerConfig, oldMemberConfigs, err := state.ParseServerConfigs(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse existing server configuration", err.Error())
+		return
+	}
+
+	diags = r.applyServerConfigs(server, serverConfig, memberConfigs, oldServerConfig, oldMemberConfigs)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete unsets all server configuration keys managed by the resource.
+// Other server configuration keys are left intact.
+func (r ServerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServerConfigModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	oldServerConfig, oldMemberConfigs, err := state.ParseServerConfigs(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse existing server configuration", err.Error())
+		return
+	}
+
+	diags = r.applyServerConfigs(server, nil, nil, oldServerConfig, oldMemberConfigs)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyServerConfigs applies the server configuration and member-specific
+// configurations. Keys present in the old configurations, but not in the new
+// ones, are unset. Keys that are not managed by the resource are left intact.
+func (r ServerConfigResource) applyServerConfigs(server lxd.InstanceServer, serverConfig map[string]string, memberConfigs map[string]map[string]string, oldServerConfig map[string]string, oldMemberConfigs map[string]map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := updateServerConfig(server, serverConfig, oldServerConfig)
+	if err != nil {
+		diags.AddError("Failed to update server configuration", err.Error())
+		return diags
+	}
+
+	// Collect members from both configurations, so that keys are also
+	// unset on members that are no longer present in the new configuration.
+	memberNames := slices.Sorted(maps.Keys(memberConfigs))
+	for memberName := range oldMemberConfigs {
+		if !slices.Contains(memberNames, memberName) {
+			memberNames = append(memberNames, memberName)
+		}
+	}
+
+	for _, memberName := range memberNames {
+		memberServer := server.UseTarget(memberName)
+
+		err := updateServerConfig(memberServer, memberConfigs[memberName], oldMemberConfigs[memberName])
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to update server configuration on member %q", memberName), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// updateServerConfig sets the given config keys on the server and unsets
+// the old config keys that are not present in the new config. The server
+// is not updated if there is nothing to change.
+func updateServerConfig(server lxd.InstanceServer, config map[string]string, oldConfig map[string]string) error {
+	if len(config) == 0 && len(oldConfig) == 0 {
+		return nil
+	}
+
+	apiServer, etag, err := server.GetServer()
+	if err != nil {
+		return err
+	}
+
+	newServer := apiServer.Writable()
+	newConfig := maps.Clone(newServer.Config)
+	if newConfig == nil {
+		newConfig = make(map[string]string, len(config))
+	}
+
+	for k := range oldConfig {
+		_, ok := config[k]
+		if !ok {
+			delete(newConfig, k)
+		}
+	}
+
+	maps.Copy(newConfig, config)
+
+	if maps.Equal(newConfig, newServer.Config) {
+		return nil
+	}
+
+	newServer.Config = newConfig
+	return server.UpdateServer(newServer, etag)
+}
+
+// SyncState fetches the server's current configuration and updates the
+// provided model with the values of the managed keys. It then applies this
+// updated model as the new state in Terraform.
+func (r ServerConfigResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m ServerConfigModel) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	serverConfig, memberConfigs, err := m.ParseServerConfigs(ctx, server)
+	if err != nil {
+		respDiags.AddError("Failed to parse server configuration", err.Error())
+		return respDiags
+	}
+
+	apiServer, _, err := server.GetServer()
+	if err != nil {
+		respDiags.AddError("Failed to retrieve server configuration", err.Error())
+		return respDiags
+	}
+
+	userConfig, diags := common.ToConfigMap(ctx, m.Config)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Apply live values for each managed global key. Keys that are no
+	// longer set on the server are removed from the state. Member-specific
+	// keys are kept as configured, as their drift is tracked by "members".
+	config := make(map[string]string, len(userConfig))
+	for k, v := range userConfig {
+		_, isGlobal := serverConfig[k]
+		if !isGlobal {
+			config[k] = v
+			continue
+		}
+
+		liveValue, ok := apiServer.Config[k]
+		if ok {
+			config[k] = liveValue
+		}
+	}
+
+	for memberName, memberConfig := range memberConfigs {
+		memberServer := server.UseTarget(memberName)
+
+		memberAPIServer, _, err := memberServer.GetServer()
+		if err != nil {
+			respDiags.AddError(fmt.Sprintf("Failed to retrieve server configuration on member %q", memberName), err.Error())
+			return respDiags
+		}
+
+		// Apply live values for each managed key.
+		for k := range memberConfig {
+			liveValue, ok := memberAPIServer.Config[k]
+			if ok {
+				memberConfig[k] = liveValue
+			} else {
+				delete(memberConfig, k)
+			}
+		}
+	}
+
+	configValue, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(config), m.Config)
+	if diags.HasError() {
+		return diags
+	}
+
+	membersValue, diags := toServerConfigMembersMapType(ctx, memberConfigs)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.Config = configValue
+	m.Members = membersValue
+
+	return tfState.Set(ctx, &m)
+}
+
+// ParseServerConfigs separates global and member-specific server configuration based on the
+// server metadata. It returns two maps, a map of global server configuration and a map
+// containing local server configuration for each member (merged with default local
+// configuration from field "config").
+func (m ServerConfigModel) ParseServerConfigs(ctx context.Context, server lxd.InstanceServer) (serverConfig map[string]string, memberConfigs map[string]map[string]string, err error) {
+	serverConfig, diags := common.ToConfigMap(ctx, m.Config)
+	err = errors.FromDiagnostics(diags)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to convert server config to map: %v", err)
+	}
+
+	apiServer, _, err := server.GetServer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMemberOverrides := len(m.MemberOverrides.Elements()) > 0
+
+	// Return early if LXD is not clustered.
+	if !apiServer.Environment.ServerClustered {
+		if hasMemberOverrides {
+			return nil, nil, fmt.Errorf("Server member-specific config overrides are allowed only when LXD is clustered")
+		}
+
+		return serverConfig, nil, nil
+	}
+
+	localKeys, err := serverConfigLocalKeys(apiServer.Environment.ServerVersion, server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memberNames, err := server.GetClusterMemberNames()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Separate global and member-specific server configuration.
+	memberConfig := make(map[string]string)
+	for k, v := range serverConfig {
+		if slices.Contains(localKeys, k) {
+			memberConfig[k] = v
+			delete(serverConfig, k)
+		}
+	}
+
+	// Set member-specific config from global config to all members by default.
+	memberConfigs = make(map[string]map[string]string, len(memberNames))
+	for _, memberName := range memberNames {
+		memberConfigs[memberName] = maps.Clone(memberConfig)
+	}
+
+	// Extract member-specific config overrides.
+	memberOverrides := map[string]ServerConfigMemberModel{}
+	err = errors.FromDiagnostics(m.MemberOverrides.ElementsAs(ctx, &memberOverrides, true))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to extract member-specific config overrides: %v", err)
+	}
+
+	for memberName, override := range memberOverrides {
+		memberConfig, ok := memberConfigs[memberName]
+		if !ok {
+			return nil, nil, fmt.Errorf("Server config contains member-specific config override for a non-existent cluster member %q", memberName)
+		}
+
+		// Parse and apply member-specific override.
+		configMap, diags := common.ToConfigMap(ctx, override.Config)
+		err := errors.FromDiagnostics(diags)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Unable to convert member-specific config override to map: %v", err)
+		}
+
+		// Ensure member-specific config does not contain global keys.
+		for k := range configMap {
+			if !slices.Contains(localKeys, k) {
+				return nil, nil, fmt.Errorf("Invalid config key %q for server member %q: Only member-specific keys are allowed in per-member configuration", k, memberName)
+			}
+		}
+
+		maps.Copy(memberConfig, configMap)
+	}
+
+	return serverConfig, memberConfigs, nil
+}
+
+// serverConfigLocalKeys retrieves a list of member-specific server configuration keys.
+func serverConfigLocalKeys(serverVersion string, server lxd.InstanceServer) ([]string, error) {
+	if server.CheckExtension("metadata_configuration") != nil {
+		return serverConfigMemberSpecificKeys(), nil
+	}
+
+	meta, err := common.ServerMetadataConfiguration(serverVersion, server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverConfig, ok := meta.Configs["server"]
+	if !ok {
+		return nil, fmt.Errorf("Metadata configuration %q not found", "server")
+	}
+
+	var localKeys []string
+	for _, group := range serverConfig {
+		for _, configKeys := range group.Keys {
+			for k, v := range configKeys {
+				if v.Scope == "local" {
+					localKeys = append(localKeys, k)
+				}
+			}
+		}
+	}
+
+	return localKeys, nil
+}
+
+// serverConfigMemberSpecificKeys returns list of member-specific server config keys.
+//
+// This is mainly used for LXD servers that do not support metadata configuration
+// endpoint, which allows to determine member-specific config keys dynamically.
+func serverConfigMemberSpecificKeys() []string {
+	return []string{
+		"cluster.https_address",
+		"core.bgp_address",
+		"core.bgp_routerid",
+		"core.debug_address",
+		"core.dns_address",
+		"core.https_address",
+		"core.metrics_address",
+		"core.storage_buckets_address",
+		"core.syslog_socket",
+		"storage.backups_volume",
+		"storage.images_volume",
+	}
+}
+
+// toServerConfigMembersMapType converts member-specific server configurations
+// into types.Map.
+func toServerConfigMembersMapType(ctx context.Context, memberConfigs map[string]map[string]string) (types.Map, diag.Diagnostics) {
+	memberObjType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"config": types.MapType{ElemType: types.StringType},
+	}}
+
+	members := make(map[string]ServerConfigMemberModel, len(memberConfigs))
+	for memberName, memberConfig := range memberConfigs {
+		memberConfigType, diags := types.MapValueFrom(ctx, types.StringType, common.ToNullableConfig(memberConfig))
+		if diags.HasError() {
+			return types.MapNull(memberObjType), diags
+		}
+
+		members[memberName] = ServerConfigMemberModel{Config: memberConfigType}
+	}
+
+	return types.MapValueFrom(ctx, memberObjType, members)
+}
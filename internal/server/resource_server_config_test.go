@@ -0,0 +1,97 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccServerConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccServerConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.%", "2"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.images.auto_update_interval", "12"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.images.remote_cache_expiry", "5"),
+				),
+			},
+			{
+				// Remove a managed key.
+				Config: acctest.Provider() + testAccServerConfig_updated(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.%", "1"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.images.auto_update_interval", "24"),
+					resource.TestCheckNoResourceAttr("lxd_server_config.config", "config.images.remote_cache_expiry"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerConfig_memberOverrides(t *testing.T) {
+	members := acctest.PreCheckClustering(t, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccServerConfig_memberOverrides(members[1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.%", "2"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.images.auto_update_interval", "12"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", "config.core.debug_address", "127.0.0.1:8444"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", fmt.Sprintf("members.%s.config.core.debug_address", members[0]), "127.0.0.1:8444"),
+					resource.TestCheckResourceAttr("lxd_server_config.config", fmt.Sprintf("members.%s.config.core.debug_address", members[1]), "127.0.0.1:8445"),
+					resource.TestCheckNoResourceAttr("lxd_server_config.config", fmt.Sprintf("members.%s.config.images.auto_update_interval", members[0])),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerConfig_basic() string {
+	return `
+resource "lxd_server_config" "config" {
+  config = {
+    "images.auto_update_interval" = "12"
+    "images.remote_cache_expiry"  = "5"
+  }
+}
+	`
+}
+
+func testAccServerConfig_updated() string {
+	return `
+resource "lxd_server_config" "config" {
+  config = {
+    "images.auto_update_interval" = "24"
+  }
+}
+	`
+}
+
+func testAccServerConfig_memberOverrides(member string) string {
+	return fmt.Sprintf(`
+resource "lxd_server_config" "config" {
+  config = {
+    "images.auto_update_interval" = "12"
+    "core.debug_address"          = "127.0.0.1:8444"
+  }
+
+  member_overrides = {
+    %q = {
+      config = {
+        "core.debug_address" = "127.0.0.1:8445"
+      }
+    }
+  }
+}
+	`, member)
+}
@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+type StorageVolumesDataSourceModel struct {
+	Pool        types.String `tfsdk:"pool"`
+	Filter      types.String `tfsdk:"filter"`
+	Project     types.String `tfsdk:"project"`
+	AllProjects types.Bool   `tfsdk:"all_projects"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Computed.
+	Volumes []StorageVolumesItemModel `tfsdk:"storage_volumes"`
+}
+
+type StorageVolumesItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Project     types.String `tfsdk:"project"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	ContentType types.String `tfsdk:"content_type"`
+	Location    types.String `tfsdk:"location"`
+	Config      types.Map    `tfsdk:"config"`
+}
+
+func NewStorageVolumesDataSource() datasource.DataSource {
+	return &StorageVolumesDataSource{}
+}
+
+type StorageVolumesDataSource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+func (d *StorageVolumesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_storage_volumes", req.ProviderTypeName)
+}
+
+func (d *StorageVolumesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"filter": schema.StringAttribute{
+				Description: "LXD API filter expression, for example \"type eq custom\"",
+				Optional:    true,
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("all_projects")),
+				},
+			},
+
+			"all_projects": schema.BoolAttribute{
+				Description: "List storage volumes from all projects",
+				Optional:    true,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"storage_volumes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of storage volumes matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"project": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"content_type": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"location": schema.StringAttribute{
+							Computed: true,
+						},
+
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageVolumesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *StorageVolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state StorageVolumesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	server, err := d.provider.InstanceServer(remote, project, "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	if project == "" {
+		project = d.provider.Project(remote)
+	}
+
+	query := common.ListQuery{
+		Project:     project,
+		AllProjects: state.AllProjects.ValueBool(),
+		Recursion:   1,
+		Filter:      state.Filter.ValueString(),
+	}
+
+	poolName := state.Pool.ValueString()
+	apiPath := fmt.Sprintf("/1.0/storage-pools/%s/volumes", url.PathEscape(poolName))
+
+	var volumes []api.StorageVolume
+	err = common.QueryList(server, apiPath, query, &volumes)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve storage volumes from storage pool %q", poolName), err.Error())
+		return
+	}
+
+	state.Volumes = make([]StorageVolumesItemModel, 0, len(volumes))
+	for _, vol := range volumes {
+		config, diags := common.ToConfigMapType(ctx, common.ToNullableConfig(vol.Config), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+
+		volProject := vol.Project
+		if volProject == "" {
+			volProject = project
+		}
+
+		state.Volumes = append(state.Volumes, StorageVolumesItemModel{
+			Name:        types.StringValue(vol.Name),
+			Project:     types.StringValue(volProject),
+			Description: types.StringValue(vol.Description),
+			Type:        types.StringValue(vol.Type),
+			ContentType: types.StringValue(vol.ContentType),
+			Location:    types.StringValue(vol.Location),
+			Config:      config,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
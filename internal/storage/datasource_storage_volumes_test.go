@@ -0,0 +1,62 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccStorageVolumes_DS_filter(t *testing.T) {
+	poolName := acctest.GenerateName(2, "-")
+	volumeName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccStorageVolumes_DS_filter(poolName, volumeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.0.name", volumeName),
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.0.project", "default"),
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.0.type", "custom"),
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.0.content_type", "filesystem"),
+					resource.TestCheckResourceAttr("data.lxd_storage_volumes.volumes", "storage_volumes.0.config.user.role", "data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageVolumes_DS_filter(poolName string, volumeName string) string {
+	return fmt.Sprintf(`
+resource "lxd_storage_pool" "pool1" {
+  name   = %q
+  driver = "dir"
+}
+
+resource "lxd_storage_volume" "volume1" {
+  name = %q
+  pool = lxd_storage_pool.pool1.name
+
+  config = {
+    "user.role" = "data"
+  }
+}
+
+resource "lxd_storage_volume" "volume2" {
+  name = "%[2]s-other"
+  pool = lxd_storage_pool.pool1.name
+}
+
+data "lxd_storage_volumes" "volumes" {
+  pool   = lxd_storage_pool.pool1.name
+  filter = "type eq custom and config.user.role eq data"
+
+  depends_on = [lxd_storage_volume.volume1, lxd_storage_volume.volume2]
+}
+`, poolName, volumeName)
+}
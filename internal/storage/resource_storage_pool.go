@@ -8,6 +8,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,14 +29,15 @@ import (
 
 // StoragePoolModel represents a LXD storage pool.
 type StoragePoolModel struct {
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	Driver          types.String `tfsdk:"driver"`
-	Project         types.String `tfsdk:"project"`
-	Remote          types.String `tfsdk:"remote"`
-	Config          types.Map    `tfsdk:"config"`
-	MemberOverrides types.Map    `tfsdk:"member_overrides"`
-	Members         types.Map    `tfsdk:"members"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	Driver          types.String   `tfsdk:"driver"`
+	Project         types.String   `tfsdk:"project"`
+	Remote          types.String   `tfsdk:"remote"`
+	Config          types.Map      `tfsdk:"config"`
+	MemberOverrides types.Map      `tfsdk:"member_overrides"`
+	Members         types.Map      `tfsdk:"members"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 // StoragePoolMemberModel represents a per-member storage pool configuration override.
@@ -57,7 +59,7 @@ func (r StoragePoolResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_storage_pool"
 }
 
-func (r StoragePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r StoragePoolResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -132,6 +134,9 @@ func (r StoragePoolResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -218,6 +223,15 @@ func (r StoragePoolResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -304,6 +318,15 @@ func (r StoragePoolResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -325,6 +348,15 @@ func (r StoragePoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
@@ -394,7 +426,7 @@ func (r StoragePoolResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update Terraform state.
-	diags := r.SyncState(ctx, &resp.State, server, plan, false)
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -407,6 +439,15 @@ func (r StoragePoolResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	server, err := r.provider.InstanceServer(remote, project, "")
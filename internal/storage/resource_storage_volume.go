@@ -7,6 +7,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -35,9 +36,13 @@ type StorageVolumeModel struct {
 	Target      types.String `tfsdk:"target"`
 	Remote      types.String `tfsdk:"remote"`
 	Config      types.Map    `tfsdk:"config"`
+	Restore     types.String `tfsdk:"restore"`
 
 	// Computed.
 	Location types.String `tfsdk:"location"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // StorageVolumeResource represent LXD storage volume resource.
@@ -54,7 +59,7 @@ func (r StorageVolumeResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_storage_volume"
 }
 
-func (r StorageVolumeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r StorageVolumeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -101,7 +106,6 @@ func (r StorageVolumeResource) Schema(_ context.Context, _ resource.SchemaReques
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -132,11 +136,21 @@ func (r StorageVolumeResource) Schema(_ context.Context, _ resource.SchemaReques
 				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
 			},
 
+			"restore": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
 			// Computed.
 
 			"location": schema.StringAttribute{
 				Computed: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -156,6 +170,31 @@ func (r *StorageVolumeResource) Configure(_ context.Context, req resource.Config
 	r.provider = provider
 }
 
+func (r *StorageVolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// A new volume has no snapshots to restore from.
+	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+		var name types.String
+		var restore types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("restore"), &restore)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !restore.IsNull() && !restore.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("restore"),
+				"Cannot restore storage volume on creation",
+				fmt.Sprintf("Storage volume %q does not exist yet, so it cannot be restored from snapshot %q. Create the volume first and set \"restore\" afterwards.", name.ValueString(), restore.ValueString()),
+			)
+			return
+		}
+	}
+
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
 func (r StorageVolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan StorageVolumeModel
 
@@ -165,6 +204,15 @@ func (r StorageVolumeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	target := plan.Target.ValueString()
@@ -224,6 +272,15 @@ func (r StorageVolumeResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	target := state.Target.ValueString()
@@ -240,13 +297,23 @@ func (r StorageVolumeResource) Read(ctx context.Context, req resource.ReadReques
 
 func (r StorageVolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan StorageVolumeModel
+	var state StorageVolumeModel
 
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	project := plan.Project.ValueString()
 	target := plan.Target.ValueString()
@@ -259,6 +326,31 @@ func (r StorageVolumeResource) Update(ctx context.Context, req resource.UpdateRe
 	poolName := plan.Pool.ValueString()
 	volName := plan.Name.ValueString()
 	volType := plan.Type.ValueString()
+
+	// Restore volume from a snapshot if the restore value has changed.
+	snapshotName := plan.Restore.ValueString()
+	if snapshotName != "" && snapshotName != state.Restore.ValueString() {
+		_, etag, err := server.GetStoragePoolVolume(poolName, volType, volName)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing storage volume %q", volName), err.Error())
+			return
+		}
+
+		volReq := api.StorageVolumePut{
+			Restore: snapshotName,
+		}
+
+		op, err := server.UpdateStoragePoolVolume(poolName, volType, volName, volReq, etag)
+		if err == nil {
+			err = op.WaitContext(ctx)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to restore storage volume %q from snapshot %q", volName, snapshotName), err.Error())
+			return
+		}
+	}
+
 	vol, etag, err := server.GetStoragePoolVolume(poolName, volType, volName)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve existing storage volume %q", volName), err.Error())
@@ -304,6 +396,15 @@ func (r StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	project := state.Project.ValueString()
 	target := state.Target.ValueString()
@@ -340,7 +441,7 @@ func (r StorageVolumeResource) ImportState(ctx context.Context, req resource.Imp
 	}
 
 	if fields["project"] == "" {
-		fields["project"] = provider_config.DefaultProject
+		fields["project"] = r.provider.Project(fields["remote"])
 	}
 
 	for k, v := range fields {
@@ -6,29 +6,32 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
 	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
 )
 
 // StorageVolumeCopyModel represents a LXD storage volume copy.
 type StorageVolumeCopyModel struct {
-	Name          types.String `tfsdk:"name"`
-	Pool          types.String `tfsdk:"pool"`
-	SourceName    types.String `tfsdk:"source_name"`
-	SourcePool    types.String `tfsdk:"source_pool"`
-	SourceProject types.String `tfsdk:"source_project"`
-	SourceRemote  types.String `tfsdk:"source_remote"`
-	Project       types.String `tfsdk:"project"`
-	Target        types.String `tfsdk:"target"`
-	Remote        types.String `tfsdk:"remote"`
+	Name          types.String   `tfsdk:"name"`
+	Pool          types.String   `tfsdk:"pool"`
+	SourceName    types.String   `tfsdk:"source_name"`
+	SourcePool    types.String   `tfsdk:"source_pool"`
+	SourceProject types.String   `tfsdk:"source_project"`
+	SourceRemote  types.String   `tfsdk:"source_remote"`
+	Project       types.String   `tfsdk:"project"`
+	Target        types.String   `tfsdk:"target"`
+	Remote        types.String   `tfsdk:"remote"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // StorageVolumeCopyResource represent LXD storage volume copy resource.
@@ -45,7 +48,7 @@ func (r StorageVolumeCopyResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_storage_volume_copy"
 }
 
-func (r StorageVolumeCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r StorageVolumeCopyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -89,7 +92,6 @@ func (r StorageVolumeCopyResource) Schema(_ context.Context, _ resource.SchemaRe
 			"source_project": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString(provider_config.DefaultProject),
 				Description: "The project from which the source volume is copied.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -102,7 +104,6 @@ func (r StorageVolumeCopyResource) Schema(_ context.Context, _ resource.SchemaRe
 			"project": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  stringdefault.StaticString(provider_config.DefaultProject),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -128,6 +129,9 @@ func (r StorageVolumeCopyResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -147,6 +151,11 @@ func (r *StorageVolumeCopyResource) Configure(_ context.Context, req resource.Co
 	r.provider = provider
 }
 
+func (r *StorageVolumeCopyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+	common.ModifyPlanProjectAt(ctx, r.provider, req, resp, path.Root("source_project"), path.Root("source_remote"))
+}
+
 func (r StorageVolumeCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan StorageVolumeCopyModel
 
@@ -156,6 +165,15 @@ func (r StorageVolumeCopyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	dstProject := plan.Project.ValueString()
 	dstTarget := plan.Target.ValueString()
 	dstServer, err := r.provider.InstanceServer(plan.Remote.ValueString(), dstProject, dstTarget)
@@ -195,7 +213,7 @@ func (r StorageVolumeCopyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	err = opCopy.Wait()
+	err = common.WaitRemoteOperation(ctx, opCopy)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to copy storage volume %q -> %q", srcVolID, dstVolID), err.Error())
 		return
@@ -0,0 +1,475 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	lxd "github.com/canonical/lxd/client"
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/common"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// StorageVolumeSnapshotModel represents a LXD storage volume snapshot.
+type StorageVolumeSnapshotModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Pool        types.String `tfsdk:"pool"`
+	Volume      types.String `tfsdk:"volume"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Project     types.String `tfsdk:"project"`
+	Target      types.String `tfsdk:"target"`
+	Remote      types.String `tfsdk:"remote"`
+
+	// Computed.
+	CreatedAt types.Int64 `tfsdk:"created_at"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// StorageVolumeSnapshotResource represents a LXD storage volume snapshot resource.
+type StorageVolumeSnapshotResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewStorageVolumeSnapshotResource returns a new storage volume snapshot resource.
+func NewStorageVolumeSnapshotResource() resource.Resource {
+	return &StorageVolumeSnapshotResource{}
+}
+
+func (r StorageVolumeSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_volume_snapshot"
+}
+
+func (r StorageVolumeSnapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+
+			"pool": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"volume": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"expires_at": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					common.ExpiryValidator{},
+				},
+			},
+
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"target": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"remote": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed.
+
+			"created_at": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
+		},
+	}
+}
+
+func (r *StorageVolumeSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *StorageVolumeSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanProject(ctx, r.provider, req, resp)
+}
+
+func (r StorageVolumeSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageVolumeSnapshotModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	target := plan.Target.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, target)
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	poolName := plan.Pool.ValueString()
+	volName := plan.Volume.ValueString()
+	snapshotName := plan.Name.ValueString()
+
+	snapshotReq := api.StorageVolumeSnapshotsPost{
+		Name: snapshotName,
+	}
+
+	// Use the expiry of the volume ("snapshots.expiry") unless
+	// it is explicitly configured.
+	if !plan.ExpiresAt.IsUnknown() && !plan.ExpiresAt.IsNull() {
+		expiresAt, err := common.ToExpiryTime(plan.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expiry", err.Error())
+			return
+		}
+
+		snapshotReq.ExpiresAt = &expiresAt
+	}
+
+	op, err := server.CreateStoragePoolVolumeSnapshot(poolName, "custom", volName, snapshotReq)
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create snapshot %q for storage volume %q", snapshotName, volName), err.Error())
+		return
+	}
+
+	diags = plan.TaintState(ctx, &resp.State)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Description cannot be set when the snapshot is created.
+	if plan.Description.ValueString() != "" {
+		snapshot, etag, err := server.GetStoragePoolVolumeSnapshot(poolName, "custom", volName, snapshotName)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve snapshot %q for storage volume %q", snapshotName, volName), err.Error())
+			return
+		}
+
+		snapshotPut := api.StorageVolumeSnapshotPut{
+			Description: plan.Description.ValueString(),
+			ExpiresAt:   snapshot.ExpiresAt,
+		}
+
+		err = server.UpdateStoragePoolVolumeSnapshot(poolName, "custom", volName, snapshotName, snapshotPut, etag)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update snapshot %q for storage volume %q", snapshotName, volName), err.Error())
+			return
+		}
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r StorageVolumeSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageVolumeSnapshotModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	target := state.Target.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, target)
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, state, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r StorageVolumeSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageVolumeSnapshotModel
+	var state StorageVolumeSnapshotModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := plan.Remote.ValueString()
+	project := plan.Project.ValueString()
+	target := plan.Target.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, target)
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	poolName := plan.Pool.ValueString()
+	volName := plan.Volume.ValueString()
+	oldName := state.Name.ValueString()
+	newName := plan.Name.ValueString()
+
+	// Rename snapshot if its name has changed.
+	if oldName != newName {
+		snapshotReq := api.StorageVolumeSnapshotPost{
+			Name: newName,
+		}
+
+		op, err := server.RenameStoragePoolVolumeSnapshot(poolName, "custom", volName, oldName, snapshotReq)
+		if err == nil {
+			err = op.WaitContext(ctx)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to rename snapshot %q for storage volume %q to %q", oldName, volName, newName), err.Error())
+			return
+		}
+
+		// Ensure the renamed snapshot is tracked even if a
+		// subsequent update fails.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), newName)...)
+	}
+
+	_, etag, err := server.GetStoragePoolVolumeSnapshot(poolName, "custom", volName, newName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to retrieve snapshot %q for storage volume %q", newName, volName), err.Error())
+		return
+	}
+
+	expiresAt, err := common.ToExpiryTime(plan.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expiry", err.Error())
+		return
+	}
+
+	snapshotPut := api.StorageVolumeSnapshotPut{
+		Description: plan.Description.ValueString(),
+		ExpiresAt:   &expiresAt,
+	}
+
+	err = server.UpdateStoragePoolVolumeSnapshot(poolName, "custom", volName, newName, snapshotPut, etag)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update snapshot %q for storage volume %q", newName, volName), err.Error())
+		return
+	}
+
+	// Update Terraform state.
+	diags = r.SyncState(ctx, &resp.State, server, plan, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r StorageVolumeSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageVolumeSnapshotModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	remote := state.Remote.ValueString()
+	project := state.Project.ValueString()
+	target := state.Target.ValueString()
+	server, err := r.provider.InstanceServer(remote, project, target)
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	poolName := state.Pool.ValueString()
+	volName := state.Volume.ValueString()
+	snapshotName := state.Name.ValueString()
+
+	op, err := server.DeleteStoragePoolVolumeSnapshot(poolName, "custom", volName, snapshotName)
+	if err == nil {
+		err = op.WaitContext(ctx)
+	}
+
+	if err != nil && !errors.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove snapshot %q for storage volume %q", snapshotName, volName), err.Error())
+		return
+	}
+}
+
+func (r StorageVolumeSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	meta := common.ImportMetadata{
+		ResourceName:   "storage_volume_snapshot",
+		RequiredFields: []string{"pool", "volume", "name"},
+	}
+
+	fields, diags := meta.ParseImportID(req.ID)
+	if diags != nil {
+		resp.Diagnostics.Append(diags)
+		return
+	}
+
+	if fields["project"] == "" {
+		fields["project"] = r.provider.Project(fields["remote"])
+	}
+
+	for k, v := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(k), v)...)
+	}
+}
+
+// TaintState marks the state with identity fields required to target the storage volume snapshot.
+func (m StorageVolumeSnapshotModel) TaintState(ctx context.Context, tfState *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(tfState.SetAttribute(ctx, path.Root("name"), m.Name.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("pool"), m.Pool.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("volume"), m.Volume.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("project"), m.Project.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("remote"), m.Remote.ValueString())...)
+	diags.Append(tfState.SetAttribute(ctx, path.Root("target"), m.Target.ValueString())...)
+
+	return diags
+}
+
+// SyncState fetches the server's current state for a storage volume snapshot
+// and updates the provided model. It then applies this updated model as the
+// new state in Terraform.
+func (r StorageVolumeSnapshotResource) SyncState(ctx context.Context, tfState *tfsdk.State, server lxd.InstanceServer, m StorageVolumeSnapshotModel, forgetOnNotFound bool) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	poolName := m.Pool.ValueString()
+	volName := m.Volume.ValueString()
+	snapshotName := m.Name.ValueString()
+
+	snapshot, _, err := server.GetStoragePoolVolumeSnapshot(poolName, "custom", volName, snapshotName)
+	if err != nil {
+		if forgetOnNotFound && errors.IsNotFoundError(err) {
+			tfState.RemoveResource(ctx)
+			return nil
+		}
+
+		respDiags.AddError(fmt.Sprintf("Failed to sync state for snapshot %q of storage volume %q", snapshotName, volName), err.Error())
+		return respDiags
+	}
+
+	// Keep the expiry in its configured format if it represents
+	// the same point in time as the one reported by the server.
+	expiresAt := ""
+	if snapshot.ExpiresAt != nil {
+		expiresAt = common.FromExpiryTime(*snapshot.ExpiresAt)
+	}
+
+	configured, err := common.ToExpiryTime(m.ExpiresAt.ValueString())
+	if m.ExpiresAt.IsUnknown() || err != nil || common.FromExpiryTime(configured) != expiresAt {
+		m.ExpiresAt = types.StringValue(expiresAt)
+	}
+
+	m.Description = types.StringValue(snapshot.Description)
+	m.CreatedAt = types.Int64Value(snapshot.CreatedAt.Unix())
+
+	return tfState.Set(ctx, &m)
+}
@@ -0,0 +1,98 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccStorageVolumeSnapshot_basic(t *testing.T) {
+	poolName := acctest.GenerateName(2, "-")
+	volumeName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+	snapshotRename := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotName, "Initial snapshot", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "name", snapshotName),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "pool", poolName),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "volume", volumeName),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "description", "Initial snapshot"),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "expires_at", ""),
+					resource.TestCheckResourceAttrSet("lxd_storage_volume_snapshot.snapshot1", "created_at"),
+				),
+			},
+			{
+				// Rename the snapshot and set its expiry in place.
+				Config: acctest.Provider() + testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotRename, "Renamed snapshot", "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "name", snapshotRename),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "description", "Renamed snapshot"),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "expires_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+			{
+				// Remove the expiry.
+				Config: acctest.Provider() + testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotRename, "Renamed snapshot", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "name", snapshotRename),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageVolumeSnapshot_importBasic(t *testing.T) {
+	poolName := acctest.GenerateName(2, "-")
+	volumeName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+	resourceName := "lxd_storage_volume_snapshot.snapshot1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotName, "", ""),
+			},
+			{
+				Config:                               acctest.Provider() + testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotName, "", ""),
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("/%s/%s/%s", poolName, volumeName, snapshotName),
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerify:                    true,
+				ImportState:                          true,
+			},
+		},
+	})
+}
+
+func testAccStorageVolumeSnapshot_basic(poolName, volumeName, snapshotName, description, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "lxd_storage_pool" "pool1" {
+  name   = "%s"
+  driver = "dir"
+}
+
+resource "lxd_storage_volume" "volume1" {
+  name = "%s"
+  pool = lxd_storage_pool.pool1.name
+}
+
+resource "lxd_storage_volume_snapshot" "snapshot1" {
+  name        = "%s"
+  description = "%s"
+  pool        = lxd_storage_volume.volume1.pool
+  volume      = lxd_storage_volume.volume1.name
+  expires_at  = "%s"
+}
+	`, poolName, volumeName, snapshotName, description, expiresAt)
+}
@@ -2,6 +2,7 @@ package storage_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -241,6 +242,51 @@ func TestAccStorageVolume_inheritedStoragePoolKeys(t *testing.T) {
 	})
 }
 
+func TestAccStorageVolume_restore(t *testing.T) {
+	poolName := acctest.GenerateName(2, "-")
+	volumeName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccStorageVolume_restore(poolName, volumeName, snapshotName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_storage_volume.volume1", "name", volumeName),
+					resource.TestCheckNoResourceAttr("lxd_storage_volume.volume1", "restore"),
+					resource.TestCheckResourceAttr("lxd_storage_volume_snapshot.snapshot1", "name", snapshotName),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccStorageVolume_restore(poolName, volumeName, snapshotName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_storage_volume.volume1", "name", volumeName),
+					resource.TestCheckResourceAttr("lxd_storage_volume.volume1", "restore", snapshotName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageVolume_restoreOnCreate(t *testing.T) {
+	poolName := acctest.GenerateName(2, "-")
+	volumeName := acctest.GenerateName(2, "-")
+	snapshotName := acctest.GenerateName(2, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.Provider() + testAccStorageVolume_restore(poolName, volumeName, snapshotName, true),
+				ExpectError: regexp.MustCompile("Cannot restore storage volume on creation"),
+			},
+		},
+	})
+}
+
 func testAccStorageVolume_basic(poolName, volumeName string) string {
 	return fmt.Sprintf(`
 resource "lxd_storage_pool" "pool1" {
@@ -387,3 +433,29 @@ resource "lxd_storage_volume" "volume1" {
 }
 	`, poolName, volumeName)
 }
+
+func testAccStorageVolume_restore(poolName, volumeName, snapshotName string, restore bool) string {
+	restoreConfig := ""
+	if restore {
+		restoreConfig = fmt.Sprintf("restore = %q", snapshotName)
+	}
+
+	return fmt.Sprintf(`
+resource "lxd_storage_pool" "pool1" {
+  name   = "%s"
+  driver = "dir"
+}
+
+resource "lxd_storage_volume" "volume1" {
+  name = "%s"
+  pool = lxd_storage_pool.pool1.name
+  %s
+}
+
+resource "lxd_storage_volume_snapshot" "snapshot1" {
+  name   = "%s"
+  pool   = lxd_storage_volume.volume1.pool
+  volume = lxd_storage_volume.volume1.name
+}
+	`, poolName, volumeName, restoreConfig, snapshotName)
+}
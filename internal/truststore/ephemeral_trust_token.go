@@ -0,0 +1,210 @@
+package truststore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/errors"
+	provider_config "github.com/terraform-lxd/terraform-provider-lxd/internal/provider-config"
+)
+
+// trustTokenPrivateKey is the private data key under which the information
+// required to clean up the trust token is stored between Open and Close.
+const trustTokenPrivateKey = "trust_token"
+
+type TrustTokenEphemeralModel struct {
+	Name          types.String `tfsdk:"name"`
+	Projects      types.List   `tfsdk:"projects"`
+	Remote        types.String `tfsdk:"remote"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+
+	// Computed.
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// trustTokenPrivateData contains the information required to remove the
+// pending trust token when the ephemeral resource is closed.
+type trustTokenPrivateData struct {
+	Remote      string `json:"remote"`
+	OperationID string `json:"operation_id"`
+}
+
+// TrustTokenEphemeralResource represent LXD trust token ephemeral resource.
+type TrustTokenEphemeralResource struct {
+	provider *provider_config.LxdProviderConfig
+}
+
+// NewTrustTokenEphemeralResource returns a new trust token ephemeral resource.
+func NewTrustTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TrustTokenEphemeralResource{}
+}
+
+func (r TrustTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust_token"
+}
+
+func (r TrustTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the token.",
+			},
+
+			"projects": schema.ListAttribute{
+				Optional:    true,
+				Description: "List of projects to restrict the token to. By default, no restriction applies.",
+				ElementType: types.StringType,
+			},
+
+			"remote": schema.StringAttribute{
+				Optional:    true,
+				Description: "The remote in which the trust token is created. If not provided, the provider's default remote is used.",
+			},
+
+			"revoke_on_close": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to remove the token, if not yet used, once Terraform no longer needs it. Defaults to true.",
+			},
+
+			// Computed.
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Generated trust token.",
+			},
+
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time when trust token will expire.",
+			},
+		},
+	}
+}
+
+func (r *TrustTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	data := req.ProviderData
+	if data == nil {
+		return
+	}
+
+	provider, ok := data.(*provider_config.LxdProviderConfig)
+	if !ok {
+		resp.Diagnostics.Append(errors.NewProviderDataTypeError(req.ProviderData))
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r TrustTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config TrustTokenEphemeralModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := config.Remote.ValueString()
+	server, err := r.provider.InstanceServer(remote, "default", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	tokenName := config.Name.ValueString()
+
+	// Get list of project to restrict the token to.
+	tokenProjects, diags := ToProjectList(ctx, config.Projects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new token.
+	tokenPost := api.CertificatesPost{
+		Name:       tokenName,
+		Type:       "client",
+		Token:      true,
+		Projects:   tokenProjects,
+		Restricted: len(tokenProjects) > 0,
+	}
+
+	op, err := server.CreateCertificateToken(tokenPost)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create trust token %q", tokenName), err.Error())
+		return
+	}
+
+	opAPI := op.Get()
+	token, err := opAPI.ToCertificateAddToken()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to convert operation into trust token: %q", tokenName), err.Error())
+		return
+	}
+
+	// Store the operation ID to remove the pending token on close.
+	if config.RevokeOnClose.IsNull() || config.RevokeOnClose.ValueBool() {
+		privateData, err := json.Marshal(trustTokenPrivateData{
+			Remote:      remote,
+			OperationID: opAPI.ID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to encode private data for trust token %q", tokenName), err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, trustTokenPrivateKey, privateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config.Token = types.StringValue(token.String())
+	config.ExpiresAt = types.StringValue(token.ExpiresAt.Format("2006/01/02 15:04 MST"))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r TrustTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, trustTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData trustTokenPrivateData
+	err := json.Unmarshal(privateBytes, &privateData)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to decode private data of trust token", err.Error())
+		return
+	}
+
+	server, err := r.provider.InstanceServer(privateData.Remote, "default", "")
+	if err != nil {
+		resp.Diagnostics.Append(errors.NewInstanceServerError(err))
+		return
+	}
+
+	op, _, err := getTrustToken(server, privateData.OperationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve trust token", err.Error())
+		return
+	}
+
+	// Remove the operation if found. Otherwise, the token was already
+	// used or has expired.
+	if op != nil {
+		err = server.DeleteOperation(op.ID)
+		if err != nil && !errors.IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Failed to remove trust token", err.Error())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package truststore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
+)
+
+func TestAccTrustToken_ephemeral(t *testing.T) {
+	tokenName := acctest.GenerateName(2, "-")
+
+	// The token that is not revoked on close is left pending.
+	t.Cleanup(func() { acctest.DeleteTrustToken(t, tokenName) })
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccTrustToken_ephemeral(tokenName, "token", true, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.token", "data.name", tokenName),
+					resource.TestCheckResourceAttrSet("echo.token", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.token", "data.expires_at"),
+					acctest.CheckValueNotPersisted("echo.token", "data.token"),
+					acctest.CheckTrustTokenPending(tokenName, false),
+				),
+			},
+			{
+				Config: acctest.Provider() + testAccTrustToken_ephemeral(tokenName, "token_kept", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.token_kept", "data.name", tokenName),
+					resource.TestCheckResourceAttrSet("echo.token_kept", "data.token"),
+					acctest.CheckValueNotPersisted("echo.token_kept", "data.token"),
+					acctest.CheckTrustTokenPending(tokenName, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustToken_ephemeral(name string, echoName string, revokeOnClose bool, projects ...string) string {
+	return fmt.Sprintf(`
+ephemeral "lxd_trust_token" "token" {
+  name            = "%s"
+  projects        = [%s]
+  revoke_on_close = %t
+}
+
+provider "echo" {
+  data = ephemeral.lxd_trust_token.token
+}
+
+resource "echo" %q {}
+	`, name, acctest.QuoteStrings(projects), revokeOnClose, echoName)
+}
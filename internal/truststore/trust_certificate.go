@@ -11,12 +11,14 @@ import (
 	lxd "github.com/canonical/lxd/client"
 	lxdShared "github.com/canonical/lxd/shared"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -36,8 +38,15 @@ type TrustCertificateModel struct {
 	Projects types.List   `tfsdk:"projects"`
 	Remote   types.String `tfsdk:"remote"`
 
+	// Write-only.
+	ContentWO        types.String `tfsdk:"content_wo"`
+	ContentWOVersion types.Int64  `tfsdk:"content_wo_version"`
+
 	// Computed.
 	Fingerprint types.String `tfsdk:"fingerprint"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // TrustCertificateResource represent LXD trust certificate resource.
@@ -54,7 +63,7 @@ func (r TrustCertificateResource) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_trust_certificate"
 }
 
-func (r TrustCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r TrustCertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -84,11 +93,27 @@ func (r TrustCertificateResource) Schema(_ context.Context, _ resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.ExactlyOneOf(
 						path.MatchRoot("content"),
+						path.MatchRoot("content_wo"),
 						path.MatchRoot("path"),
 					),
 				},
 			},
 
+			"content_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only content of the client certificate. The value is never stored in the Terraform plan or state.",
+			},
+
+			"content_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the write-only certificate content. Changing the version forces the certificate to be replaced.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+
 			"projects": schema.ListAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -113,6 +138,9 @@ func (r TrustCertificateResource) Schema(_ context.Context, _ resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -146,6 +174,36 @@ func (r *TrustCertificateResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	// Write-only content is available only in the configuration.
+	var contentWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_wo"), &contentWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !contentWO.IsNull() {
+		var state *TrustCertificateModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Unknown write-only content cannot be compared, therefore keep
+		// the existing fingerprint until the version changes. Changing
+		// the version forces the certificate to be replaced.
+		if contentWO.IsUnknown() {
+			if state != nil && state.ContentWOVersion.Equal(plan.ContentWOVersion) {
+				resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), state.Fingerprint)
+			} else {
+				resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), types.StringUnknown())
+			}
+
+			return
+		}
+
+		plan.Content = contentWO
+	}
+
 	// We need to parse the certificate ahead of time, and evaluate it's fingerprint.
 	// If fingerprint has changed, it will force recreation of the certificate.
 	certName := plan.Name.ValueString()
@@ -175,7 +233,18 @@ func (r *TrustCertificateResource) ModifyPlan(ctx context.Context, req resource.
 	}
 
 	// Calculate certificate fingerprint.
-	resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), lxdShared.CertFingerprint(x509Cert))
+	fingerprint := lxdShared.CertFingerprint(x509Cert)
+	resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), fingerprint)
+
+	// Changes of the write-only content are not reflected in the plan,
+	// therefore the replacement must be requested explicitly.
+	if !contentWO.IsNull() && !req.State.Raw.IsNull() {
+		var stateFingerprint types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fingerprint"), &stateFingerprint)...)
+		if stateFingerprint.ValueString() != fingerprint {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("fingerprint"))
+		}
+	}
 }
 
 func (r TrustCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -187,6 +256,15 @@ func (r TrustCertificateResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -204,9 +282,20 @@ func (r TrustCertificateResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Get certificate content.
+	// Get certificate content. Write-only content is available
+	// only in the configuration.
+	var contentWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_wo"), &contentWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	certPath := plan.Path.ValueString()
 	certContent := []byte(plan.Content.ValueString())
+	if !contentWO.IsNull() {
+		certContent = []byte(contentWO.ValueString())
+	}
+
 	if certPath != "" {
 		certContent, err = os.ReadFile(certPath)
 		if err != nil {
@@ -263,6 +352,15 @@ func (r TrustCertificateResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -285,6 +383,15 @@ func (r TrustCertificateResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Set update timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	certFingerprint := state.Fingerprint.ValueString()
 
 	remote := plan.Remote.ValueString()
@@ -338,6 +445,15 @@ func (r TrustCertificateResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "", "")
 	if err != nil {
@@ -9,6 +9,8 @@ import (
 
 	lxdShared "github.com/canonical/lxd/shared"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/acctest"
 	"github.com/terraform-lxd/terraform-provider-lxd/internal/truststore"
 )
@@ -36,6 +38,67 @@ func TestAccTrustCertificate_content(t *testing.T) {
 	})
 }
 
+func TestAccTrustCertificate_contentWriteOnly(t *testing.T) {
+	certName := acctest.GenerateName(2, "-")
+	cert1, fingerprint1 := generateCert(t)
+	cert2, fingerprint2 := generateCert(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Provider() + testAccTrustCertificate_contentWriteOnly(certName, cert1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "name", certName),
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "fingerprint", fingerprint1),
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "content_wo_version", "1"),
+					// Ensure certificate is not stored.
+					resource.TestCheckNoResourceAttr("lxd_trust_certificate.cert", "content"),
+					resource.TestCheckNoResourceAttr("lxd_trust_certificate.cert", "content_wo"),
+				),
+			},
+			{
+				// Change of the certificate is detected by its fingerprint.
+				Config: acctest.Provider() + testAccTrustCertificate_contentWriteOnly(certName, cert2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "fingerprint", fingerprint2),
+					resource.TestCheckNoResourceAttr("lxd_trust_certificate.cert", "content_wo"),
+				),
+			},
+			{
+				// Change of the version forces replacement.
+				Config: acctest.Provider() + testAccTrustCertificate_contentWriteOnly(certName, cert2, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_trust_certificate.cert", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "fingerprint", fingerprint2),
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "content_wo_version", "2"),
+				),
+			},
+			{
+				// Change of both the version and the certificate.
+				Config: acctest.Provider() + testAccTrustCertificate_contentWriteOnly(certName, cert1, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lxd_trust_certificate.cert", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "fingerprint", fingerprint1),
+					resource.TestCheckResourceAttr("lxd_trust_certificate.cert", "content_wo_version", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTrustCertificate_path(t *testing.T) {
 	certName := acctest.GenerateName(2, "-")
 	certPath := filepath.Join(t.TempDir(), "client.crt")
@@ -165,6 +228,18 @@ EOF
 	`, name, strings.TrimRight(cert, "\n"), acctest.QuoteStrings(projects))
 }
 
+func testAccTrustCertificate_contentWriteOnly(name string, cert string, version int) string {
+	return fmt.Sprintf(`
+resource "lxd_trust_certificate" "cert" {
+  name       = "%s"
+  content_wo = <<-EOF
+%s
+EOF
+  content_wo_version = %d
+}
+	`, name, strings.TrimRight(cert, "\n"), version)
+}
+
 func testAccTrustCertificate_path(name string, certPath string, projects ...string) string {
 	return fmt.Sprintf(`
 resource "lxd_trust_certificate" "cert" {
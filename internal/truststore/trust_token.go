@@ -6,6 +6,7 @@ import (
 
 	lxd "github.com/canonical/lxd/client"
 	"github.com/canonical/lxd/shared/api"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,6 +32,9 @@ type TrustTokenModel struct {
 	Token       types.String `tfsdk:"token"`
 	ExpiresAt   types.String `tfsdk:"expires_at"`
 	OperationID types.String `tfsdk:"operation_id"`
+
+	// Timeouts.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // TrustTokenResource represent LXD trust token resource.
@@ -47,7 +51,7 @@ func (r TrustTokenResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_trust_token"
 }
 
-func (r TrustTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r TrustTokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -109,6 +113,9 @@ func (r TrustTokenResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"operation_id": schema.StringAttribute{
 				Computed: true,
 			},
+
+			// Custom timeouts
+			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
 }
@@ -137,6 +144,15 @@ func (r TrustTokenResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Set creation timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := plan.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "default", "")
 	if err != nil {
@@ -196,6 +212,15 @@ func (r TrustTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Set read timeout.
+	timeout, diags := state.Timeouts.Read(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "default", "")
 	if err != nil {
@@ -239,6 +264,15 @@ func (r TrustTokenResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	// Set delete timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, r.provider.DefaultTimeout())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	remote := state.Remote.ValueString()
 	server, err := r.provider.InstanceServer(remote, "default", "")
 	if err != nil {